@@ -57,6 +57,12 @@ func run() error {
 	}
 	defer workerCleanup()
 
+	authConf, err := agent.NewSyncAuthFromEnv()
+	if err != nil {
+		logger.Err(err).Msg("error configuring agent auth")
+		return err
+	}
+
 	srv := agent.NewService(agent.ServiceConfig{
 		Logger: logging.Component(logger, "service"),
 		Agent:  worker,
@@ -73,6 +79,7 @@ func run() error {
 					LogResponses: viper.GetBool(agent.LogResponses),
 				},
 			),
+			interceptors.NewAuthInterceptor(logger, authConf),
 		),
 	))
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/nicjohnson145/plantr/internal/cli"
+	"github.com/nicjohnson145/plantr/internal/logging"
+	"github.com/spf13/viper"
+)
+
+func newAdminCLI() (*cli.CLI, error) {
+	if err := cli.InitConfig(); err != nil {
+		fmt.Printf("error initializing config: %v\n", err)
+		return nil, err
+	}
+	logger := logging.Init(&logging.LoggingConfig{
+		Level:  logging.LogLevel(viper.GetString(cli.LoggingLevel)),
+		Format: logging.LogFormat(viper.GetString(cli.LoggingFormat)),
+	})
+
+	client, err := cli.NewAdminClientFromEnv()
+	if err != nil {
+		logger.Err(err).Msg("error creating controller client")
+		return nil, err
+	}
+
+	return cli.NewCLI(cli.CLIConfig{
+		Logger:     logger,
+		Controller: client,
+	}), nil
+}
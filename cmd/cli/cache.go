@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/nicjohnson145/plantr/internal/agent"
+	"github.com/nicjohnson145/plantr/internal/cli"
+	"github.com/nicjohnson145/plantr/internal/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func cache() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the download cache",
+	}
+
+	cmd.AddCommand(
+		cachePrune(),
+	)
+
+	return cmd
+}
+
+func cachePrune() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Clear the download cache",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.InitConfig(); err != nil {
+				fmt.Printf("error initializing config: %v\n", err)
+				return err
+			}
+			logger := logging.Init(&logging.LoggingConfig{
+				Level:  logging.LogLevel(viper.GetString(cli.LoggingLevel)),
+				Format: logging.LogFormat(viper.GetString(cli.LoggingFormat)),
+			})
+
+			c := cli.NewCLI(cli.CLIConfig{
+				Logger:        logger,
+				DownloadCache: agent.NewDownloadCacheFromEnv(logging.Component(logger, "download-cache")),
+			})
+
+			if err := c.CachePrune(); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
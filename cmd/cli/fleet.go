@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func fleet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fleet",
+		Short: "Inspect the fleet",
+		Long:  "Query the controller for the status of every node in the fleet. Requires an admin api key",
+	}
+
+	cmd.AddCommand(
+		fleetList(),
+		fleetShow(),
+	)
+
+	return cmd
+}
+
+func fleetList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List nodes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.FleetList(); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func fleetShow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <node>",
+		Short: "Show node status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.FleetShow(args[0]); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nicjohnson145/plantr/internal/cli"
+	"github.com/nicjohnson145/plantr/internal/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func generateAdminToken() *cobra.Command {
+	var duration time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "generate-admin-token",
+		Short: "Generate an admin token",
+		Long:  "Sign an admin token with the controller's JWT signing key, read from JWT_SIGNING_KEY. Admin commands use it when set as ADMIN_API_KEY",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.InitConfig(); err != nil {
+				fmt.Printf("error initializing config: %v\n", err)
+				return err
+			}
+
+			logger := logging.Init(&logging.LoggingConfig{
+				Level:  logging.LogLevel(viper.GetString(cli.LoggingLevel)),
+				Format: logging.LogFormat(viper.GetString(cli.LoggingFormat)),
+			})
+
+			c := cli.NewCLI(cli.CLIConfig{
+				Logger: logger,
+			})
+
+			if err := c.GenerateAdminToken([]byte(viper.GetString(cli.JWTSigningKey)), duration); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+	cmd.Flags().DurationVar(&duration, "duration", 24*time.Hour, "How long the token is valid for")
+
+	return cmd
+}
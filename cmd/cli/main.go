@@ -25,8 +25,16 @@ func root() *cobra.Command {
 
 	cmd.AddCommand(
 		generateKeyPair(),
+		generateAdminToken(),
 		sync(),
 		forceRefresh(),
+		fleet(),
+		cache(),
+		outdated(),
+		rateLimits(),
+		promote(),
+		resetChannel(),
+		revisions(),
 	)
 
 	return cmd
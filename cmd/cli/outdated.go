@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func outdated() *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "outdated",
+		Short: "List outdated pins",
+		Long:  "Compare every pinned github_release, go_install, golang and git_repo tag seed against the latest upstream version. Requires an admin api key",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.Outdated(all); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "include seeds that are already up to date")
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func promote() *cobra.Command {
+	var from string
+
+	cmd := &cobra.Command{
+		Use:   "promote <channel>",
+		Short: "Promote a commit to a channel",
+		Long:  "Pin a channel to the commit another channel is currently serving, i.e promote canary to stable. Requires an admin api key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.Promote(from, args[0]); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "channel to promote from, defaults to the default channel")
+
+	return cmd
+}
+
+func resetChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-channel <channel>",
+		Short: "Undo a promotion",
+		Long:  "Drop a channel's promotion so it follows its own branch or commit again, or the default channel if it has neither. Requires an admin api key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.ResetChannel(args[0]); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
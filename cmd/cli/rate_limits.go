@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func rateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Show GitHub API quota",
+		Long:  "Show the GitHub API rate limit the controller last saw for each host it talks to. Requires an admin api key",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.RateLimits(); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func revisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "Inspect and pin config revisions",
+		Long:  "List the config repo commits the controller has loaded, and pin it to one to roll back a bad change. Requires an admin api key",
+	}
+
+	cmd.AddCommand(
+		revisionsList(),
+		revisionsPin(),
+		revisionsUnpin(),
+	)
+
+	return cmd
+}
+
+func revisionsList() *cobra.Command {
+	var limit int32
+
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List loaded revisions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.RevisionsList(limit); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int32Var(&limit, "limit", 20, "most revisions to show")
+
+	return cmd
+}
+
+func revisionsPin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin <commit>",
+		Short: "Pin the config to a commit",
+		Long:  "Serve the config at the given commit until unpinned, ignoring webhooks and refreshes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.RevisionsPin(args[0]); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func revisionsUnpin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpin",
+		Short: "Go back to the latest commit",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newAdminCLI()
+			if err != nil {
+				return err
+			}
+
+			if err := c.RevisionsUnpin(); err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -11,9 +11,12 @@ import (
 )
 
 func sync() *cobra.Command {
+	var agentAddress string
+
 	cmd := &cobra.Command{
 		Use:   "sync",
 		Short: "Sync configuration",
+		Long:  "Sync configuration in process, or on the running agent at --agent",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cli.InitConfig(); err != nil {
 				fmt.Printf("error initializing config: %v\n", err)
@@ -24,6 +27,25 @@ func sync() *cobra.Command {
 				Format: logging.LogFormat(viper.GetString(cli.LoggingFormat)),
 			})
 
+			if agentAddress != "" {
+				client, err := cli.NewAgentClientFromEnv(agentAddress)
+				if err != nil {
+					logger.Err(err).Msg("error creating agent client")
+					return err
+				}
+
+				c := cli.NewCLI(cli.CLIConfig{
+					Logger:      logger,
+					AgentClient: client,
+				})
+				if err := c.SyncRemote(); err != nil {
+					logger.Error().Msg("error executing sync")
+					fmt.Println(err)
+					return err
+				}
+				return nil
+			}
+
 			worker, workerCleanup, err := agent.NewAgentFromEnv(logger)
 			if err != nil {
 				logger.Err(err).Msg("error creating agent")
@@ -46,6 +68,7 @@ func sync() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().StringVar(&agentAddress, "agent", "", "Address of a running agent to sync, i.e http://localhost:8090. Requires an admin api key")
 
 	return cmd
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/rsa"
 	"errors"
 	"fmt"
 	"net"
@@ -14,9 +15,9 @@ import (
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
-	"github.com/nicjohnson145/hlp/set"
 	"github.com/nicjohnson145/plantr/gen/plantr/controller/v1/controllerv1connect"
 	"github.com/nicjohnson145/plantr/internal/controller"
+	"github.com/nicjohnson145/plantr/internal/encryption"
 	"github.com/nicjohnson145/plantr/internal/interceptors"
 	"github.com/nicjohnson145/plantr/internal/logging"
 	"github.com/spf13/viper"
@@ -47,6 +48,33 @@ func run() error {
 		return fmt.Errorf("must provide JWT signing key")
 	}
 
+	// Admin bits
+	adminKey := viper.GetString(controller.AdminAPIKey)
+	if adminKey == "" {
+		logger.Warn().Msg("no admin api key provided, admin RPCs will only accept admin tokens from `plantr generate-admin-token`")
+	}
+
+	// Agent auth bits
+	var agentAuthKey *rsa.PrivateKey
+	if keyPath := viper.GetString(controller.AgentAuthPrivateKeyPath); keyPath != "" {
+		keyBytes, err := os.ReadFile(keyPath)
+		if err != nil {
+			logger.Err(err).Msg("error reading agent auth private key")
+			return err
+		}
+		agentAuthKey, err = encryption.DecodePrivateKey(string(keyBytes))
+		if err != nil {
+			logger.Err(err).Msg("error decoding agent auth private key")
+			return err
+		}
+	}
+
+	pushSyncEnabled := viper.GetBool(controller.PushSyncEnabled)
+	if pushSyncEnabled && agentAuthKey == nil && viper.GetString(controller.AgentAuthSecret) == "" {
+		logger.Error().Msg("push sync requires an agent auth secret or private key")
+		return fmt.Errorf("push sync requires an agent auth secret or private key")
+	}
+
 	storage, storageCleanup, err := controller.NewStorageClientFromEnv(logging.Component(logger, "storage"))
 	defer storageCleanup()
 	if err != nil {
@@ -54,12 +82,35 @@ func run() error {
 		return err
 	}
 
-	gitClient, err := controller.NewGitFromEnv(logging.Component(logger, "git"))
+	githubApp, err := controller.NewGithubAppFromEnv(logging.Component(logger, "github-app"))
+	if err != nil {
+		logger.Err(err).Msg("error initializing github app")
+		return err
+	}
+
+	githubTransport := controller.NewGithubTransport(controller.GithubTransportConfig{
+		Logger:  logging.Component(logger, "github-transport"),
+		MaxWait: viper.GetDuration(controller.GithubRateLimitMaxWait),
+	})
+
+	gitClient, err := controller.NewGitFromEnv(logging.Component(logger, "git"), githubApp, githubTransport)
 	if err != nil {
 		logger.Err(err).Msg("error initializing git client")
 		return err
 	}
 
+	releaseHosts, err := controller.NewReleaseHostsFromEnv(logging.Component(logger, "release-hosts"))
+	if err != nil {
+		logger.Err(err).Msg("error reading release hosts")
+		return err
+	}
+
+	channels, err := controller.NewChannelsFromEnv()
+	if err != nil {
+		logger.Err(err).Msg("error reading channels")
+		return err
+	}
+
 	vaultClient, err := controller.NewVaultFromEnv(logging.Component(logger, "vault"))
 	if err != nil {
 		logger.Err(err).Msg("error initializing vault client")
@@ -79,15 +130,32 @@ func run() error {
 	}
 
 	ctrl, err := controller.NewController(controller.ControllerConfig{
-		Logger:              logging.Component(logger, "service"),
-		StorageClient:       storage,
-		GitClient:           gitClient,
-		RepoURL:             url,
-		JWTSigningKey:       []byte(jwtKeyStr),
-		JWTDuration:         viper.GetDuration(controller.JWTDuration),
-		VaultClient:         vaultClient,
-		GithubReleaseToken:  viper.GetString(controller.GitAccessToken),
-		GithubWebhookSecret: []byte(viper.GetString(controller.GithubWebhookSecret)),
+		Logger:               logging.Component(logger, "service"),
+		StorageClient:        storage,
+		GitClient:            gitClient,
+		RepoURL:              url,
+		JWTSigningKey:        []byte(jwtKeyStr),
+		JWTDuration:          viper.GetDuration(controller.JWTDuration),
+		VaultClient:          vaultClient,
+		GithubReleaseToken:   viper.GetString(controller.GitAccessToken),
+		GithubTokenSource:    githubApp,
+		GithubApiURL:         viper.GetString(controller.GithubApiUrl),
+		ReleaseHosts:         releaseHosts,
+		GithubTransport:      githubTransport,
+		GithubWebhookSecret:  []byte(viper.GetString(controller.GithubWebhookSecret)),
+		Branch:               viper.GetString(controller.GitBranch),
+		TagPattern:           viper.GetString(controller.GitTagPattern),
+		Path:                 viper.GetString(controller.GitPath),
+		Channels:             channels,
+		PollInterval:         viper.GetDuration(controller.GitPollInterval),
+		AgentAuthSecret:      []byte(viper.GetString(controller.AgentAuthSecret)),
+		AgentAuthPrivateKey:  agentAuthKey,
+		PushSyncEnabled:      pushSyncEnabled,
+		PushSyncConcurrency:  viper.GetInt(controller.PushSyncConcurrency),
+		PushSyncTimeout:      viper.GetDuration(controller.PushSyncTimeout),
+		RenderConcurrency:    viper.GetInt(controller.RenderConcurrency),
+		ReleaseTagTTL:        viper.GetDuration(controller.GithubReleaseTagTTL),
+		ReleaseLookupTimeout: viper.GetDuration(controller.ReleaseLookupTimeout),
 	})
 	if err != nil {
 		logger.Err(err).Msg("error initializing controller")
@@ -107,10 +175,11 @@ func run() error {
 			),
 			interceptors.NewAuthInterceptor(
 				logger,
-				[]byte(jwtKeyStr),
-				set.New(
-					"/plantr.controller.v1.ControllerService/Login",
-				),
+				interceptors.AuthInterceptorConfig{
+					SigningKey:    []byte(jwtKeyStr),
+					AdminAPIKey:   []byte(adminKey),
+					Authorization: interceptors.ControllerAuthorizationTable,
+				},
 			),
 		),
 	))
@@ -136,7 +205,12 @@ func run() error {
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	wg := sync.WaitGroup{}
-	wg.Add(1)
+	wg.Add(2)
+
+	go func() {
+		ctrl.Poll(ctx)
+		wg.Done()
+	}()
 
 	go func() {
 		s := <-sigChan
@@ -154,5 +228,6 @@ func run() error {
 		return err
 	}
 
+	wg.Wait()
 	return nil
 }
@@ -35,17 +35,21 @@ const (
 const (
 	// AgentServiceSyncProcedure is the fully-qualified name of the AgentService's Sync RPC.
 	AgentServiceSyncProcedure = "/plantr.agent.v1.AgentService/Sync"
+	// AgentServiceSyncStreamProcedure is the fully-qualified name of the AgentService's SyncStream RPC.
+	AgentServiceSyncStreamProcedure = "/plantr.agent.v1.AgentService/SyncStream"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	agentServiceServiceDescriptor    = v1.File_plantr_agent_v1_service_proto.Services().ByName("AgentService")
-	agentServiceSyncMethodDescriptor = agentServiceServiceDescriptor.Methods().ByName("Sync")
+	agentServiceServiceDescriptor          = v1.File_plantr_agent_v1_service_proto.Services().ByName("AgentService")
+	agentServiceSyncMethodDescriptor       = agentServiceServiceDescriptor.Methods().ByName("Sync")
+	agentServiceSyncStreamMethodDescriptor = agentServiceServiceDescriptor.Methods().ByName("SyncStream")
 )
 
 // AgentServiceClient is a client for the plantr.agent.v1.AgentService service.
 type AgentServiceClient interface {
 	Sync(context.Context, *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error)
+	SyncStream(context.Context, *connect.Request[v1.SyncStreamRequest]) (*connect.ServerStreamForClient[v1.SyncEvent], error)
 }
 
 // NewAgentServiceClient constructs a client for the plantr.agent.v1.AgentService service. By
@@ -64,12 +68,19 @@ func NewAgentServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(agentServiceSyncMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		syncStream: connect.NewClient[v1.SyncStreamRequest, v1.SyncEvent](
+			httpClient,
+			baseURL+AgentServiceSyncStreamProcedure,
+			connect.WithSchema(agentServiceSyncStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // agentServiceClient implements AgentServiceClient.
 type agentServiceClient struct {
-	sync *connect.Client[v1.SyncRequest, v1.SyncResponse]
+	sync       *connect.Client[v1.SyncRequest, v1.SyncResponse]
+	syncStream *connect.Client[v1.SyncStreamRequest, v1.SyncEvent]
 }
 
 // Sync calls plantr.agent.v1.AgentService.Sync.
@@ -77,9 +88,15 @@ func (c *agentServiceClient) Sync(ctx context.Context, req *connect.Request[v1.S
 	return c.sync.CallUnary(ctx, req)
 }
 
+// SyncStream calls plantr.agent.v1.AgentService.SyncStream.
+func (c *agentServiceClient) SyncStream(ctx context.Context, req *connect.Request[v1.SyncStreamRequest]) (*connect.ServerStreamForClient[v1.SyncEvent], error) {
+	return c.syncStream.CallServerStream(ctx, req)
+}
+
 // AgentServiceHandler is an implementation of the plantr.agent.v1.AgentService service.
 type AgentServiceHandler interface {
 	Sync(context.Context, *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error)
+	SyncStream(context.Context, *connect.Request[v1.SyncStreamRequest], *connect.ServerStream[v1.SyncEvent]) error
 }
 
 // NewAgentServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -94,10 +111,18 @@ func NewAgentServiceHandler(svc AgentServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(agentServiceSyncMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	agentServiceSyncStreamHandler := connect.NewServerStreamHandler(
+		AgentServiceSyncStreamProcedure,
+		svc.SyncStream,
+		connect.WithSchema(agentServiceSyncStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/plantr.agent.v1.AgentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AgentServiceSyncProcedure:
 			agentServiceSyncHandler.ServeHTTP(w, r)
+		case AgentServiceSyncStreamProcedure:
+			agentServiceSyncStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -110,3 +135,7 @@ type UnimplementedAgentServiceHandler struct{}
 func (UnimplementedAgentServiceHandler) Sync(context.Context, *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.agent.v1.AgentService.Sync is not implemented"))
 }
+
+func (UnimplementedAgentServiceHandler) SyncStream(context.Context, *connect.Request[v1.SyncStreamRequest], *connect.ServerStream[v1.SyncEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("plantr.agent.v1.AgentService.SyncStream is not implemented"))
+}
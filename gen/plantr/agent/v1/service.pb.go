@@ -20,6 +20,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SyncEventKind int32
+
+const (
+	SyncEventKind_SYNC_EVENT_KIND_UNSPECIFIED SyncEventKind = 0
+	// SYNC_STARTED is sent once the agent knows how many seeds it will process
+	SyncEventKind_SYNC_EVENT_KIND_SYNC_STARTED   SyncEventKind = 1
+	SyncEventKind_SYNC_EVENT_KIND_SEED_STARTED   SyncEventKind = 2
+	SyncEventKind_SYNC_EVENT_KIND_SEED_SKIPPED   SyncEventKind = 3
+	SyncEventKind_SYNC_EVENT_KIND_SEED_SUCCEEDED SyncEventKind = 4
+	SyncEventKind_SYNC_EVENT_KIND_SEED_FAILED    SyncEventKind = 5
+	// SYNC_FINISHED is the last event of a sync, successful or not
+	SyncEventKind_SYNC_EVENT_KIND_SYNC_FINISHED SyncEventKind = 6
+)
+
+// Enum value maps for SyncEventKind.
+var (
+	SyncEventKind_name = map[int32]string{
+		0: "SYNC_EVENT_KIND_UNSPECIFIED",
+		1: "SYNC_EVENT_KIND_SYNC_STARTED",
+		2: "SYNC_EVENT_KIND_SEED_STARTED",
+		3: "SYNC_EVENT_KIND_SEED_SKIPPED",
+		4: "SYNC_EVENT_KIND_SEED_SUCCEEDED",
+		5: "SYNC_EVENT_KIND_SEED_FAILED",
+		6: "SYNC_EVENT_KIND_SYNC_FINISHED",
+	}
+	SyncEventKind_value = map[string]int32{
+		"SYNC_EVENT_KIND_UNSPECIFIED":    0,
+		"SYNC_EVENT_KIND_SYNC_STARTED":   1,
+		"SYNC_EVENT_KIND_SEED_STARTED":   2,
+		"SYNC_EVENT_KIND_SEED_SKIPPED":   3,
+		"SYNC_EVENT_KIND_SEED_SUCCEEDED": 4,
+		"SYNC_EVENT_KIND_SEED_FAILED":    5,
+		"SYNC_EVENT_KIND_SYNC_FINISHED":  6,
+	}
+)
+
+func (x SyncEventKind) Enum() *SyncEventKind {
+	p := new(SyncEventKind)
+	*p = x
+	return p
+}
+
+func (x SyncEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_plantr_agent_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SyncEventKind) Type() protoreflect.EnumType {
+	return &file_plantr_agent_v1_service_proto_enumTypes[0]
+}
+
+func (x SyncEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncEventKind.Descriptor instead.
+func (SyncEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_plantr_agent_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
 type SyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -96,6 +159,143 @@ func (*SyncResponse) Descriptor() ([]byte, []int) {
 	return file_plantr_agent_v1_service_proto_rawDescGZIP(), []int{1}
 }
 
+type SyncStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncStreamRequest) Reset() {
+	*x = SyncStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_agent_v1_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStreamRequest) ProtoMessage() {}
+
+func (x *SyncStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_agent_v1_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStreamRequest.ProtoReflect.Descriptor instead.
+func (*SyncStreamRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_agent_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+type SyncEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind SyncEventKind `protobuf:"varint,1,opt,name=kind,proto3,enum=plantr.agent.v1.SyncEventKind" json:"kind,omitempty"`
+	// Total is the number of seeds in the sync, only set on SYNC_STARTED
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Index is the position of the seed within the sync, starting at 1
+	Index       int32  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Hash        string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Message is a human readable log line describing the event
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// Error is the failure reason for SEED_FAILED and SYNC_FINISHED events
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SyncEvent) Reset() {
+	*x = SyncEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_agent_v1_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncEvent) ProtoMessage() {}
+
+func (x *SyncEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_agent_v1_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncEvent.ProtoReflect.Descriptor instead.
+func (*SyncEvent) Descriptor() ([]byte, []int) {
+	return file_plantr_agent_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SyncEvent) GetKind() SyncEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SyncEventKind_SYNC_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *SyncEvent) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SyncEvent) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SyncEvent) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SyncEvent) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SyncEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SyncEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_plantr_agent_v1_service_proto protoreflect.FileDescriptor
 
 var file_plantr_agent_v1_service_proto_rawDesc = []byte{
@@ -103,25 +303,61 @@ var file_plantr_agent_v1_service_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
 	0x22, 0x0d, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x0e, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0x53, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x43, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0xbe, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x63, 0x6a, 0x6f, 0x68, 0x6e,
-	0x73, 0x6f, 0x6e, 0x31, 0x34, 0x35, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76,
-	0x31, 0x3b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa,
-	0x02, 0x0f, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0f, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x11, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x3a, 0x3a, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0e, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x13, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0xfe, 0x01, 0x0a, 0x0d, 0x53, 0x79,
+	0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x53,
+	0x59, 0x4e, 0x43, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20,
+	0x0a, 0x1c, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x06, 0x32, 0xa3, 0x01, 0x0a, 0x0c, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x53,
+	0x79, 0x6e, 0x63, 0x12, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4e, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x22,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x42, 0xbe, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x63, 0x6a, 0x6f, 0x68, 0x6e, 0x73, 0x6f, 0x6e, 0x31,
+	0x34, 0x35, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x72, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x0f, 0x50, 0x6c,
+	0x61, 0x6e, 0x74, 0x72, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f,
+	0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1b, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11,
+	0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x3a, 0x3a, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -136,19 +372,26 @@ func file_plantr_agent_v1_service_proto_rawDescGZIP() []byte {
 	return file_plantr_agent_v1_service_proto_rawDescData
 }
 
-var file_plantr_agent_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_plantr_agent_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_plantr_agent_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_plantr_agent_v1_service_proto_goTypes = []any{
-	(*SyncRequest)(nil),  // 0: plantr.agent.v1.SyncRequest
-	(*SyncResponse)(nil), // 1: plantr.agent.v1.SyncResponse
+	(SyncEventKind)(0),        // 0: plantr.agent.v1.SyncEventKind
+	(*SyncRequest)(nil),       // 1: plantr.agent.v1.SyncRequest
+	(*SyncResponse)(nil),      // 2: plantr.agent.v1.SyncResponse
+	(*SyncStreamRequest)(nil), // 3: plantr.agent.v1.SyncStreamRequest
+	(*SyncEvent)(nil),         // 4: plantr.agent.v1.SyncEvent
 }
 var file_plantr_agent_v1_service_proto_depIdxs = []int32{
-	0, // 0: plantr.agent.v1.AgentService.Sync:input_type -> plantr.agent.v1.SyncRequest
-	1, // 1: plantr.agent.v1.AgentService.Sync:output_type -> plantr.agent.v1.SyncResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: plantr.agent.v1.SyncEvent.kind:type_name -> plantr.agent.v1.SyncEventKind
+	1, // 1: plantr.agent.v1.AgentService.Sync:input_type -> plantr.agent.v1.SyncRequest
+	3, // 2: plantr.agent.v1.AgentService.SyncStream:input_type -> plantr.agent.v1.SyncStreamRequest
+	2, // 3: plantr.agent.v1.AgentService.Sync:output_type -> plantr.agent.v1.SyncResponse
+	4, // 4: plantr.agent.v1.AgentService.SyncStream:output_type -> plantr.agent.v1.SyncEvent
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_plantr_agent_v1_service_proto_init() }
@@ -181,19 +424,44 @@ func file_plantr_agent_v1_service_proto_init() {
 				return nil
 			}
 		}
+		file_plantr_agent_v1_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SyncStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_agent_v1_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SyncEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plantr_agent_v1_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_plantr_agent_v1_service_proto_goTypes,
 		DependencyIndexes: file_plantr_agent_v1_service_proto_depIdxs,
+		EnumInfos:         file_plantr_agent_v1_service_proto_enumTypes,
 		MessageInfos:      file_plantr_agent_v1_service_proto_msgTypes,
 	}.Build()
 	File_plantr_agent_v1_service_proto = out.File
@@ -85,23 +85,139 @@ func (x *ConfigFile) GetMode() string {
 	return ""
 }
 
+// Signature describes a detached signature to verify a download against
+type Signature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// the public key, as the contents of a minisign .pub file or an armored OpenPGP key
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Types that are assignable to Location:
+	//
+	//	*Signature_Asset
+	//	*Signature_Url
+	Location isSignature_Location `protobuf_oneof:"location"`
+}
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Signature) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Signature) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (m *Signature) GetLocation() isSignature_Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (x *Signature) GetAsset() string {
+	if x, ok := x.GetLocation().(*Signature_Asset); ok {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *Signature) GetUrl() string {
+	if x, ok := x.GetLocation().(*Signature_Url); ok {
+		return x.Url
+	}
+	return ""
+}
+
+type isSignature_Location interface {
+	isSignature_Location()
+}
+
+type Signature_Asset struct {
+	// name of the release asset holding the signature, github_release only. Templated with .Name, the name of the
+	// downloaded asset
+	Asset string `protobuf:"bytes,3,opt,name=asset,proto3,oneof"`
+}
+
+type Signature_Url struct {
+	// url of the signature. Templated with .Url, the download url, and .Name, its filename
+	Url string `protobuf:"bytes,4,opt,name=url,proto3,oneof"`
+}
+
+func (*Signature_Asset) isSignature_Location() {}
+
+func (*Signature_Url) isSignature_Location() {}
+
 type GithubRelease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repo           string                      `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	AssetPatterns  *GithubRelease_AssetPattern `protobuf:"bytes,2,opt,name=asset_patterns,json=assetPatterns,proto3" json:"asset_patterns,omitempty"`
-	Tag            string                      `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	NameOverride   *string                     `protobuf:"bytes,4,opt,name=name_override,json=nameOverride,proto3,oneof" json:"name_override,omitempty"`
-	ArchiveRelease bool                        `protobuf:"varint,5,opt,name=archive_release,json=archiveRelease,proto3" json:"archive_release,omitempty"`
-	BinaryRegex    *string                     `protobuf:"bytes,6,opt,name=binary_regex,json=binaryRegex,proto3,oneof" json:"binary_regex,omitempty"`
+	Repo          string                      `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	AssetPatterns *GithubRelease_AssetPattern `protobuf:"bytes,2,opt,name=asset_patterns,json=assetPatterns,proto3" json:"asset_patterns,omitempty"`
+	// the release tag to install. Either a literal tag, "latest", or a semver constraint such as "~1.4" or ">=2.0 <3"
+	Tag            string  `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	NameOverride   *string `protobuf:"bytes,4,opt,name=name_override,json=nameOverride,proto3,oneof" json:"name_override,omitempty"`
+	ArchiveRelease bool    `protobuf:"varint,5,opt,name=archive_release,json=archiveRelease,proto3" json:"archive_release,omitempty"`
+	BinaryRegex    *string `protobuf:"bytes,6,opt,name=binary_regex,json=binaryRegex,proto3,oneof" json:"binary_regex,omitempty"`
+	// expected sha256 of the selected asset, per OS/arch
+	Sha256 *UrlDownload_OsGroup `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// find the release's checksum manifest and verify the selected asset against it, ignored for any OS/arch with an
+	// explicit sha256
+	VerifyChecksums bool       `protobuf:"varint,8,opt,name=verify_checksums,json=verifyChecksums,proto3" json:"verify_checksums,omitempty"`
+	Signature       *Signature `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	// consider prereleases when resolving "latest" or a semver constraint
+	IncludePrereleases bool `protobuf:"varint,10,opt,name=include_prereleases,json=includePrereleases,proto3" json:"include_prereleases,omitempty"`
+	// name of a release host configured on the controller, i.e a GitHub Enterprise or GitLab instance. Defaults to github.com
+	Host string `protobuf:"bytes,11,opt,name=host,proto3" json:"host,omitempty"`
+	// the forge the release is published on, one of github, gitlab or gitea. Defaults to github. Without a host,
+	// gitlab and gitea releases are looked up on gitlab.com and gitea.com
+	Provider string `protobuf:"bytes,12,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
 func (x *GithubRelease) Reset() {
 	*x = GithubRelease{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[1]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -114,7 +230,7 @@ func (x *GithubRelease) String() string {
 func (*GithubRelease) ProtoMessage() {}
 
 func (x *GithubRelease) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[1]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -127,7 +243,7 @@ func (x *GithubRelease) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubRelease.ProtoReflect.Descriptor instead.
 func (*GithubRelease) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{1}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GithubRelease) GetRepo() string {
@@ -172,6 +288,48 @@ func (x *GithubRelease) GetBinaryRegex() string {
 	return ""
 }
 
+func (x *GithubRelease) GetSha256() *UrlDownload_OsGroup {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *GithubRelease) GetVerifyChecksums() bool {
+	if x != nil {
+		return x.VerifyChecksums
+	}
+	return false
+}
+
+func (x *GithubRelease) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GithubRelease) GetIncludePrereleases() bool {
+	if x != nil {
+		return x.IncludePrereleases
+	}
+	return false
+}
+
+func (x *GithubRelease) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GithubRelease) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
 type SystemPackage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -185,7 +343,7 @@ type SystemPackage struct {
 func (x *SystemPackage) Reset() {
 	*x = SystemPackage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[2]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -198,7 +356,7 @@ func (x *SystemPackage) String() string {
 func (*SystemPackage) ProtoMessage() {}
 
 func (x *SystemPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[2]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +369,7 @@ func (x *SystemPackage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemPackage.ProtoReflect.Descriptor instead.
 func (*SystemPackage) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{2}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SystemPackage) GetApt() *SystemPackage_Apt {
@@ -252,7 +410,7 @@ type GitRepo struct {
 func (x *GitRepo) Reset() {
 	*x = GitRepo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[3]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -265,7 +423,7 @@ func (x *GitRepo) String() string {
 func (*GitRepo) ProtoMessage() {}
 
 func (x *GitRepo) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[3]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +436,7 @@ func (x *GitRepo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitRepo.ProtoReflect.Descriptor instead.
 func (*GitRepo) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{3}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GitRepo) GetUrl() string {
@@ -343,7 +501,7 @@ type Golang struct {
 func (x *Golang) Reset() {
 	*x = Golang{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[4]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -356,7 +514,7 @@ func (x *Golang) String() string {
 func (*Golang) ProtoMessage() {}
 
 func (x *Golang) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[4]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +527,7 @@ func (x *Golang) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Golang.ProtoReflect.Descriptor instead.
 func (*Golang) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{4}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Golang) GetVersion() string {
@@ -391,7 +549,7 @@ type GoInstall struct {
 func (x *GoInstall) Reset() {
 	*x = GoInstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[5]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -404,7 +562,7 @@ func (x *GoInstall) String() string {
 func (*GoInstall) ProtoMessage() {}
 
 func (x *GoInstall) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[5]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +575,7 @@ func (x *GoInstall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoInstall.ProtoReflect.Descriptor instead.
 func (*GoInstall) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{5}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GoInstall) GetPackage() string {
@@ -442,12 +600,15 @@ type UrlDownload struct {
 	Urls           *UrlDownload_OsGroup `protobuf:"bytes,1,opt,name=urls,proto3" json:"urls,omitempty"`
 	NameOverride   *string              `protobuf:"bytes,2,opt,name=name_override,json=nameOverride,proto3,oneof" json:"name_override,omitempty"`
 	ArchiveRelease bool                 `protobuf:"varint,3,opt,name=archive_release,json=archiveRelease,proto3" json:"archive_release,omitempty"`
+	// expected sha256 of the download, per OS/arch
+	Sha256    *UrlDownload_OsGroup `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Signature *Signature           `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *UrlDownload) Reset() {
 	*x = UrlDownload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[6]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -460,7 +621,7 @@ func (x *UrlDownload) String() string {
 func (*UrlDownload) ProtoMessage() {}
 
 func (x *UrlDownload) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[6]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +634,7 @@ func (x *UrlDownload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UrlDownload.ProtoReflect.Descriptor instead.
 func (*UrlDownload) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{6}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UrlDownload) GetUrls() *UrlDownload_OsGroup {
@@ -497,6 +658,20 @@ func (x *UrlDownload) GetArchiveRelease() bool {
 	return false
 }
 
+func (x *UrlDownload) GetSha256() *UrlDownload_OsGroup {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *UrlDownload) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type RoleGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -508,7 +683,7 @@ type RoleGroup struct {
 func (x *RoleGroup) Reset() {
 	*x = RoleGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[7]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -521,7 +696,7 @@ func (x *RoleGroup) String() string {
 func (*RoleGroup) ProtoMessage() {}
 
 func (x *RoleGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[7]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +709,7 @@ func (x *RoleGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoleGroup.ProtoReflect.Descriptor instead.
 func (*RoleGroup) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{7}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RoleGroup) GetRoles() []string {
@@ -566,7 +741,7 @@ type Seed struct {
 func (x *Seed) Reset() {
 	*x = Seed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[8]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -579,7 +754,7 @@ func (x *Seed) String() string {
 func (*Seed) ProtoMessage() {}
 
 func (x *Seed) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[8]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +767,7 @@ func (x *Seed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Seed.ProtoReflect.Descriptor instead.
 func (*Seed) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{8}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Seed) GetMeta() *Seed_Metadata {
@@ -728,7 +903,7 @@ type Role struct {
 func (x *Role) Reset() {
 	*x = Role{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[9]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -741,7 +916,7 @@ func (x *Role) String() string {
 func (*Role) ProtoMessage() {}
 
 func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[9]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +929,7 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Role.ProtoReflect.Descriptor instead.
 func (*Role) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{9}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Role) GetSeeds() []*Seed {
@@ -778,12 +953,16 @@ type Node struct {
 	Os             string   `protobuf:"bytes,7,opt,name=os,proto3" json:"os,omitempty"`
 	Arch           string   `protobuf:"bytes,8,opt,name=arch,proto3" json:"arch,omitempty"`
 	PackageManager string   `protobuf:"bytes,9,opt,name=package_manager,json=packageManager,proto3" json:"package_manager,omitempty"`
+	// AgentAddress is where the controller can reach the node's agent for push syncs, i.e http://my-host:8080
+	AgentAddress string `protobuf:"bytes,10,opt,name=agent_address,json=agentAddress,proto3" json:"agent_address,omitempty"`
+	// Channel is the controller channel the node is served from, i.e stable or canary. Empty uses the default channel
+	Channel string `protobuf:"bytes,11,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[10]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -796,7 +975,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[10]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +988,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{10}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Node) GetId() string {
@@ -875,6 +1054,20 @@ func (x *Node) GetPackageManager() string {
 	return ""
 }
 
+func (x *Node) GetAgentAddress() string {
+	if x != nil {
+		return x.AgentAddress
+	}
+	return ""
+}
+
+func (x *Node) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
 type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -887,7 +1080,7 @@ type Config struct {
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[11]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -900,7 +1093,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[11]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1106,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{11}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Config) GetRoles() map[string]*Role {
@@ -942,7 +1135,7 @@ type GithubRelease_AssetPattern struct {
 func (x *GithubRelease_AssetPattern) Reset() {
 	*x = GithubRelease_AssetPattern{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[12]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -955,7 +1148,7 @@ func (x *GithubRelease_AssetPattern) String() string {
 func (*GithubRelease_AssetPattern) ProtoMessage() {}
 
 func (x *GithubRelease_AssetPattern) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[12]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1161,7 @@ func (x *GithubRelease_AssetPattern) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubRelease_AssetPattern.ProtoReflect.Descriptor instead.
 func (*GithubRelease_AssetPattern) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{1, 0}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *GithubRelease_AssetPattern) GetLinux() *GithubRelease_AssetPattern_ArchPattern {
@@ -997,7 +1190,7 @@ type GithubRelease_AssetPattern_ArchPattern struct {
 func (x *GithubRelease_AssetPattern_ArchPattern) Reset() {
 	*x = GithubRelease_AssetPattern_ArchPattern{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[13]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1010,7 +1203,7 @@ func (x *GithubRelease_AssetPattern_ArchPattern) String() string {
 func (*GithubRelease_AssetPattern_ArchPattern) ProtoMessage() {}
 
 func (x *GithubRelease_AssetPattern_ArchPattern) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[13]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1216,7 @@ func (x *GithubRelease_AssetPattern_ArchPattern) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GithubRelease_AssetPattern_ArchPattern.ProtoReflect.Descriptor instead.
 func (*GithubRelease_AssetPattern_ArchPattern) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{1, 0, 0}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{2, 0, 0}
 }
 
 func (x *GithubRelease_AssetPattern_ArchPattern) GetAmd64() string {
@@ -1051,7 +1244,7 @@ type SystemPackage_Apt struct {
 func (x *SystemPackage_Apt) Reset() {
 	*x = SystemPackage_Apt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[14]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1064,7 +1257,7 @@ func (x *SystemPackage_Apt) String() string {
 func (*SystemPackage_Apt) ProtoMessage() {}
 
 func (x *SystemPackage_Apt) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[14]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1270,7 @@ func (x *SystemPackage_Apt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemPackage_Apt.ProtoReflect.Descriptor instead.
 func (*SystemPackage_Apt) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{2, 0}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *SystemPackage_Apt) GetName() string {
@@ -1098,7 +1291,7 @@ type SystemPackage_Brew struct {
 func (x *SystemPackage_Brew) Reset() {
 	*x = SystemPackage_Brew{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[15]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1111,7 +1304,7 @@ func (x *SystemPackage_Brew) String() string {
 func (*SystemPackage_Brew) ProtoMessage() {}
 
 func (x *SystemPackage_Brew) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[15]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,7 +1317,7 @@ func (x *SystemPackage_Brew) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemPackage_Brew.ProtoReflect.Descriptor instead.
 func (*SystemPackage_Brew) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{2, 1}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *SystemPackage_Brew) GetName() string {
@@ -1145,7 +1338,7 @@ type SystemPackage_Pacman struct {
 func (x *SystemPackage_Pacman) Reset() {
 	*x = SystemPackage_Pacman{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[16]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1158,7 +1351,7 @@ func (x *SystemPackage_Pacman) String() string {
 func (*SystemPackage_Pacman) ProtoMessage() {}
 
 func (x *SystemPackage_Pacman) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[16]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,7 +1364,7 @@ func (x *SystemPackage_Pacman) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemPackage_Pacman.ProtoReflect.Descriptor instead.
 func (*SystemPackage_Pacman) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{2, 2}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{3, 2}
 }
 
 func (x *SystemPackage_Pacman) GetName() string {
@@ -1193,7 +1386,7 @@ type UrlDownload_OsGroup struct {
 func (x *UrlDownload_OsGroup) Reset() {
 	*x = UrlDownload_OsGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[17]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1206,7 +1399,7 @@ func (x *UrlDownload_OsGroup) String() string {
 func (*UrlDownload_OsGroup) ProtoMessage() {}
 
 func (x *UrlDownload_OsGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[17]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1412,7 @@ func (x *UrlDownload_OsGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UrlDownload_OsGroup.ProtoReflect.Descriptor instead.
 func (*UrlDownload_OsGroup) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{6, 0}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *UrlDownload_OsGroup) GetLinux() *UrlDownload_OsGroup_ArchGroup {
@@ -1248,7 +1441,7 @@ type UrlDownload_OsGroup_ArchGroup struct {
 func (x *UrlDownload_OsGroup_ArchGroup) Reset() {
 	*x = UrlDownload_OsGroup_ArchGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[18]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1261,7 +1454,7 @@ func (x *UrlDownload_OsGroup_ArchGroup) String() string {
 func (*UrlDownload_OsGroup_ArchGroup) ProtoMessage() {}
 
 func (x *UrlDownload_OsGroup_ArchGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[18]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1467,7 @@ func (x *UrlDownload_OsGroup_ArchGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UrlDownload_OsGroup_ArchGroup.ProtoReflect.Descriptor instead.
 func (*UrlDownload_OsGroup_ArchGroup) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{6, 0, 0}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{7, 0, 0}
 }
 
 func (x *UrlDownload_OsGroup_ArchGroup) GetAmd64() string {
@@ -1302,7 +1495,7 @@ type Seed_Metadata struct {
 func (x *Seed_Metadata) Reset() {
 	*x = Seed_Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_config_v1_struct_proto_msgTypes[19]
+		mi := &file_plantr_config_v1_struct_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1315,7 +1508,7 @@ func (x *Seed_Metadata) String() string {
 func (*Seed_Metadata) ProtoMessage() {}
 
 func (x *Seed_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_config_v1_struct_proto_msgTypes[19]
+	mi := &file_plantr_config_v1_struct_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +1521,7 @@ func (x *Seed_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Seed_Metadata.ProtoReflect.Descriptor instead.
 func (*Seed_Metadata) Descriptor() ([]byte, []int) {
-	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{8, 0}
+	return file_plantr_config_v1_struct_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *Seed_Metadata) GetName() string {
@@ -1367,266 +1560,321 @@ var file_plantr_config_v1_struct_proto_rawDesc = []byte{
 	0x74, 0x68, 0x61, 0x6e, 0x20, 0x37, 0x1a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74,
 	0x63, 0x68, 0x65, 0x73, 0x28, 0x22, 0x5e, 0x5b, 0x30, 0x2d, 0x37, 0x5d, 0x7b, 0x33, 0x7d, 0x24,
 	0x22, 0x29, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x9a, 0x05, 0x0a, 0x0d, 0x47, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xba, 0x48, 0x41, 0xba, 0x01, 0x3e, 0x0a, 0x12,
-	0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65,
-	0x70, 0x6f, 0x12, 0x18, 0x72, 0x65, 0x70, 0x6f, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69,
-	0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x04, 0x72, 0x65,
-	0x70, 0x6f, 0x12, 0x53, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74,
-	0x65, 0x72, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x54, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xba, 0x48, 0x3f, 0xba, 0x01, 0x3c, 0x0a, 0x11, 0x47, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x74, 0x61, 0x67, 0x12,
-	0x17, 0x74, 0x61, 0x67, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74,
-	0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x28, 0x0a,
-	0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x12, 0x26, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x1a, 0xe5, 0x01, 0x0a, 0x0c, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x4e, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
-	0x75, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xab, 0x02, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x72, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x5e, 0xba, 0x48, 0x5b, 0xba, 0x01, 0x58, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x25, 0x6b, 0x69, 0x6e, 0x64,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x69, 0x67, 0x6e, 0x2c, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x70, 0x67,
+	0x70, 0x1a, 0x1f, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x69, 0x67, 0x6e, 0x27, 0x2c, 0x20, 0x27, 0x6f, 0x70, 0x65, 0x6e, 0x70, 0x67, 0x70,
+	0x27, 0x5d, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x6b, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xba, 0x48,
+	0x49, 0xba, 0x01, 0x46, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65,
+	0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x42, 0x11, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x05, 0xba,
+	0x48, 0x02, 0x08, 0x01, 0x22, 0x9b, 0x08, 0x0a, 0x0d, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xba, 0x48, 0x41, 0xba, 0x01, 0x3e, 0x0a, 0x12, 0x47, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x12, 0x18, 0x72, 0x65, 0x70, 0x6f, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65,
+	0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f,
+	0x12, 0x53, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
 	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x6e, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x12, 0x4a, 0x0a, 0x03, 0x6d, 0x61, 0x63,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
-	0x52, 0x03, 0x6d, 0x61, 0x63, 0x1a, 0x39, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x72,
-	0x6d, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x36, 0x34,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-	0x64, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65,
-	0x67, 0x65, 0x78, 0x22, 0xb5, 0x04, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x03, 0x61, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x2e, 0x41, 0x70, 0x74, 0x52, 0x03, 0x61, 0x70, 0x74, 0x12, 0x38, 0x0a, 0x04,
-	0x62, 0x72, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x72, 0x65, 0x77,
-	0x52, 0x04, 0x62, 0x72, 0x65, 0x77, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x52, 0x06,
-	0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x1a, 0x63, 0x0a, 0x03, 0x41, 0x70, 0x74, 0x12, 0x5c, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x48, 0xba, 0x48, 0x45,
-	0xba, 0x01, 0x42, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x2e, 0x41, 0x70, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x6e, 0x61, 0x6d,
-	0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73,
-	0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x65, 0x0a, 0x04, 0x42,
-	0x72, 0x65, 0x77, 0x12, 0x5d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x49, 0xba, 0x48, 0x46, 0xba, 0x01, 0x43, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x72, 0x65, 0x77, 0x2e, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x18, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69,
-	0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x1a, 0x24, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x80, 0x01, 0xba, 0x48, 0x7d, 0x1a, 0x7b,
-	0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
-	0x35, 0x61, 0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66,
-	0x20, 0x5b, 0x27, 0x61, 0x70, 0x74, 0x27, 0x2c, 0x20, 0x27, 0x62, 0x72, 0x65, 0x77, 0x27, 0x2c,
-	0x20, 0x27, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x27, 0x5d, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x33, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73,
-	0x2e, 0x61, 0x70, 0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69,
-	0x73, 0x2e, 0x62, 0x72, 0x65, 0x77, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74,
-	0x68, 0x69, 0x73, 0x2e, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x29, 0x22, 0xf9, 0x01, 0x0a, 0x07,
-	0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x4e, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xba, 0x48, 0x39, 0xba, 0x01, 0x36, 0x0a, 0x0b, 0x47, 0x69,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x2e, 0x75, 0x72, 0x6c, 0x12, 0x17, 0x75, 0x72, 0x6c, 0x20, 0x69,
-	0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e,
-	0x20, 0x30, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x62, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x46, 0xba, 0x48, 0x43, 0xba, 0x01,
-	0x40, 0x0a, 0x10, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x54, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x42, 0xba, 0x48, 0x3f, 0xba, 0x01, 0x3c, 0x0a, 0x11, 0x47, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x74, 0x61, 0x67, 0x12, 0x17, 0x74,
+	0x61, 0x67, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x28, 0x0a, 0x0d, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73,
+	0x12, 0x39, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x94, 0x01, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x78, 0xba, 0x48, 0x75, 0xba, 0x01, 0x72, 0x0a, 0x16, 0x47, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x2d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2c, 0x20, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2c, 0x20, 0x67, 0x69, 0x74,
+	0x65, 0x61, 0x1a, 0x29, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x27, 0x2c,
+	0x20, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x27, 0x2c, 0x20, 0x27, 0x67, 0x69, 0x74, 0x6c,
+	0x61, 0x62, 0x27, 0x2c, 0x20, 0x27, 0x67, 0x69, 0x74, 0x65, 0x61, 0x27, 0x5d, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0xe5, 0x01, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x4e, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x12, 0x4a, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52,
+	0x03, 0x6d, 0x61, 0x63, 0x1a, 0x39, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x72, 0x6d,
+	0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x22, 0xb5, 0x04, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x03, 0x61, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x2e, 0x41, 0x70, 0x74, 0x52, 0x03, 0x61, 0x70, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x62,
+	0x72, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x72, 0x65, 0x77, 0x52,
+	0x04, 0x62, 0x72, 0x65, 0x77, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x52, 0x06, 0x70,
+	0x61, 0x63, 0x6d, 0x61, 0x6e, 0x1a, 0x63, 0x0a, 0x03, 0x41, 0x70, 0x74, 0x12, 0x5c, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x48, 0xba, 0x48, 0x45, 0xba,
+	0x01, 0x42, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x2e, 0x41, 0x70, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x6e, 0x61, 0x6d, 0x65,
+	0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29,
+	0x20, 0x3e, 0x20, 0x30, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x65, 0x0a, 0x04, 0x42, 0x72,
+	0x65, 0x77, 0x12, 0x5d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x49, 0xba, 0x48, 0x46, 0xba, 0x01, 0x43, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x72, 0x65, 0x77, 0x2e, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a,
+	0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x1a, 0x24, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x80, 0x01, 0xba, 0x48, 0x7d, 0x1a, 0x7b, 0x0a,
+	0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x35,
+	0x61, 0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x5b, 0x27, 0x61, 0x70, 0x74, 0x27, 0x2c, 0x20, 0x27, 0x62, 0x72, 0x65, 0x77, 0x27, 0x2c, 0x20,
+	0x27, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x27, 0x5d, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x33, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x62, 0x72, 0x65, 0x77, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x29, 0x22, 0xf9, 0x01, 0x0a, 0x07, 0x47,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x4e, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3c, 0xba, 0x48, 0x39, 0xba, 0x01, 0x36, 0x0a, 0x0b, 0x47, 0x69, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x2e, 0x75, 0x72, 0x6c, 0x12, 0x17, 0x75, 0x72, 0x6c, 0x20, 0x69, 0x73,
 	0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c,
 	0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20,
-	0x30, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03, 0x74,
-	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
-	0x18, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x0c, 0x0a, 0x03, 0x72, 0x65, 0x66,
-	0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x67, 0x0a, 0x06, 0x47, 0x6f, 0x6c, 0x61, 0x6e,
-	0x67, 0x12, 0x5d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x43, 0xba, 0x48, 0x40, 0xba, 0x01, 0x3d, 0x0a, 0x0e, 0x47, 0x6f, 0x6c, 0x61,
-	0x6e, 0x67, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68,
-	0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x98, 0x01, 0x0a, 0x09, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x60,
-	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x46, 0xba, 0x48, 0x43, 0xba, 0x01, 0x40, 0x0a, 0x11, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68,
-	0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x03, 0x0a, 0x0b,
-	0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x75,
-	0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x1a, 0xea, 0x01, 0x0a, 0x07, 0x4f, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x45, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x2e, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x12, 0x41, 0x0a, 0x03,
-	0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x1a,
-	0x55, 0x0a, 0x09, 0x41, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x0a, 0x05,
-	0x61, 0x6d, 0x64, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x61,
-	0x6d, 0x64, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x36, 0x34,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x88,
-	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x69, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x5c, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x46, 0xba, 0x48, 0x43, 0xba, 0x01, 0x40, 0x0a, 0x0f, 0x52, 0x6f,
-	0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x61,
-	0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65,
-	0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x0e, 0x73, 0x69,
-	0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x05, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x22, 0xfc, 0x04, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x65, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0e,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x67, 0x69, 0x74, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
-	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x07, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x32,
-	0x0a, 0x06, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x06, 0x67, 0x6f, 0x6c, 0x61,
-	0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x09, 0x67, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x12, 0x42, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
-	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x1a, 0x2c, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x42, 0x10, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x05, 0xba, 0x48, 0x02,
-	0x08, 0x01, 0x22, 0x34, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x65,
-	0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65,
-	0x64, 0x52, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x22, 0x8a, 0x06, 0x0a, 0x04, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x47, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xba,
-	0x48, 0x34, 0xba, 0x01, 0x31, 0x0a, 0x07, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x69, 0x64, 0x12, 0x16,
-	0x69, 0x64, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x30, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x62, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x46, 0xba, 0x48, 0x43, 0xba, 0x01, 0x40,
+	0x0a, 0x10, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20,
+	0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x0c, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x12,
+	0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x67, 0x0a, 0x06, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
+	0x12, 0x5d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x43, 0xba, 0x48, 0x40, 0xba, 0x01, 0x3d, 0x0a, 0x0e, 0x47, 0x6f, 0x6c, 0x61, 0x6e,
+	0x67, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x98, 0x01, 0x0a, 0x09, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x60, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x46,
+	0xba, 0x48, 0x43, 0xba, 0x01, 0x40, 0x0a, 0x11, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
 	0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69,
-	0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x75, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f,
-	0xba, 0x48, 0x4c, 0xba, 0x01, 0x49, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x36, 0x34, 0x12, 0x22, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x36, 0x34, 0x20, 0x69, 0x73, 0x20, 0x61,
+	0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x04, 0x0a, 0x0b, 0x55,
+	0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x75, 0x72,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x1a, 0xea, 0x01, 0x0a, 0x07, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x45,
+	0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4f, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x12, 0x41, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x4f, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x1a, 0x55, 0x0a, 0x09, 0x41, 0x72, 0x63, 0x68,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x61, 0x6d, 0x64, 0x36, 0x34, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x22, 0x69, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x5c,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x46, 0xba,
+	0x48, 0x43, 0xba, 0x01, 0x40, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x61, 0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74,
+	0x20, 0x6f, 0x6e, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73,
+	0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xfc, 0x04, 0x0a,
+	0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
+	0x36, 0x0a, 0x08, 0x67, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x07,
+	0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x6c, 0x61, 0x6e,
+	0x67, 0x48, 0x00, 0x52, 0x06, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x67,
+	0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x09,
+	0x67, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x42, 0x0a, 0x0c, 0x75, 0x72, 0x6c,
+	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00,
+	0x52, 0x0b, 0x75, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a,
+	0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00,
+	0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x2c, 0x0a, 0x08, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x07, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x34, 0x0a, 0x04, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x52, 0x05, 0x73, 0x65, 0x65, 0x64,
+	0x73, 0x22, 0xc9, 0x06, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xba, 0x48, 0x34, 0xba, 0x01, 0x31, 0x0a, 0x07,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x69, 0x64, 0x12, 0x16, 0x69, 0x64, 0x20, 0x69, 0x73, 0x20, 0x61,
 	0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a,
 	0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52,
-	0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x42, 0x36, 0x34, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xba, 0x48, 0x42, 0xba, 0x01, 0x3f, 0x0a, 0x0e,
-	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x12, 0x1d,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73,
-	0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x5f, 0x64,
-	0x69, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x44, 0x69, 0x72,
-	0x12, 0x6d, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5d, 0xba, 0x48,
-	0x5a, 0xba, 0x01, 0x57, 0x0a, 0x07, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x73, 0x12, 0x2f, 0x6f,
-	0x73, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x74, 0x6f,
-	0x20, 0x62, 0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x5b, 0x22, 0x6c, 0x69, 0x6e,
-	0x75, 0x78, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x61, 0x72, 0x77, 0x69, 0x6e, 0x22, 0x5d, 0x1a, 0x1b,
-	0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x27,
-	0x2c, 0x20, 0x27, 0x64, 0x61, 0x72, 0x77, 0x69, 0x6e, 0x27, 0x5d, 0x52, 0x02, 0x6f, 0x73, 0x12,
-	0x73, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5f, 0xba,
-	0x48, 0x5c, 0xba, 0x01, 0x59, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x61, 0x72, 0x63, 0x68,
-	0x12, 0x30, 0x61, 0x72, 0x63, 0x68, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20,
-	0x5b, 0x22, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x72, 0x6d, 0x36, 0x34,
-	0x22, 0x5d, 0x1a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x61, 0x6d,
-	0x64, 0x36, 0x34, 0x27, 0x2c, 0x20, 0x27, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x27, 0x5d, 0x52, 0x04,
-	0x61, 0x72, 0x63, 0x68, 0x12, 0xae, 0x01, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84,
-	0x01, 0xba, 0x48, 0x80, 0x01, 0xba, 0x01, 0x7d, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x42,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x20,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x75, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x36,
+	0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0xba, 0x48, 0x4c, 0xba, 0x01, 0x49, 0x0a,
+	0x13, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x62, 0x36, 0x34, 0x12, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x62, 0x36, 0x34, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74,
+	0x68, 0x69, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x42, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x45, 0xba, 0x48, 0x42, 0xba, 0x01, 0x3f, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x12, 0x1d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f,
+	0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x44, 0x69, 0x72, 0x12, 0x6d, 0x0a, 0x02, 0x6f, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5d, 0xba, 0x48, 0x5a, 0xba, 0x01, 0x57, 0x0a, 0x07, 0x4e,
+	0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x73, 0x12, 0x2f, 0x6f, 0x73, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x6e, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x5b, 0x22, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x22, 0x2c, 0x20, 0x22, 0x64,
+	0x61, 0x72, 0x77, 0x69, 0x6e, 0x22, 0x5d, 0x1a, 0x1b, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e,
+	0x20, 0x5b, 0x27, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x27, 0x2c, 0x20, 0x27, 0x64, 0x61, 0x72, 0x77,
+	0x69, 0x6e, 0x27, 0x5d, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x73, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5f, 0xba, 0x48, 0x5c, 0xba, 0x01, 0x59, 0x0a, 0x09,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x12, 0x30, 0x61, 0x72, 0x63, 0x68, 0x20,
 	0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62,
-	0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x5b, 0x22, 0x61, 0x70, 0x74, 0x22, 0x2c,
-	0x20, 0x22, 0x62, 0x72, 0x65, 0x77, 0x22, 0x2c, 0x20, 0x22, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e,
-	0x22, 0x5d, 0x1a, 0x21, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x61, 0x70,
-	0x74, 0x27, 0x2c, 0x20, 0x27, 0x62, 0x72, 0x65, 0x77, 0x27, 0x2c, 0x20, 0x27, 0x70, 0x61, 0x63,
-	0x6d, 0x61, 0x6e, 0x27, 0x5d, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x39, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6e,
-	0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x50, 0x0a, 0x0a, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
-	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xc4, 0x01, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6e, 0x69, 0x63, 0x6a, 0x6f, 0x68, 0x6e, 0x73, 0x6f, 0x6e, 0x31, 0x34, 0x35, 0x2f, 0x70, 0x6c,
-	0x61, 0x6e, 0x74, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x43, 0x58, 0xaa, 0x02, 0x10, 0x50, 0x6c, 0x61, 0x6e, 0x74,
-	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x50, 0x6c,
-	0x61, 0x6e, 0x74, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1c, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12,
-	0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x5b, 0x22, 0x61, 0x6d, 0x64, 0x36, 0x34,
+	0x22, 0x2c, 0x20, 0x22, 0x61, 0x72, 0x6d, 0x36, 0x34, 0x22, 0x5d, 0x1a, 0x1a, 0x74, 0x68, 0x69,
+	0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x61, 0x6d, 0x64, 0x36, 0x34, 0x27, 0x2c, 0x20, 0x27,
+	0x61, 0x72, 0x6d, 0x36, 0x34, 0x27, 0x5d, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0xae, 0x01,
+	0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84, 0x01, 0xba, 0x48, 0x80, 0x01, 0xba, 0x01,
+	0x7d, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x42, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x5b, 0x22, 0x61, 0x70, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x62, 0x72, 0x65, 0x77, 0x22,
+	0x2c, 0x20, 0x22, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x22, 0x5d, 0x1a, 0x21, 0x74, 0x68, 0x69,
+	0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x61, 0x70, 0x74, 0x27, 0x2c, 0x20, 0x27, 0x62, 0x72,
+	0x65, 0x77, 0x27, 0x2c, 0x20, 0x27, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x27, 0x5d, 0x52, 0x0e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xc3, 0x01,
+	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x1a, 0x50, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0xc4, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x63, 0x6a, 0x6f, 0x68, 0x6e, 0x73,
+	0x6f, 0x6e, 0x31, 0x34, 0x35, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76,
+	0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x43, 0x58,
+	0xaa, 0x02, 0x10, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x3a, 0x3a,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1641,58 +1889,63 @@ func file_plantr_config_v1_struct_proto_rawDescGZIP() []byte {
 	return file_plantr_config_v1_struct_proto_rawDescData
 }
 
-var file_plantr_config_v1_struct_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_plantr_config_v1_struct_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_plantr_config_v1_struct_proto_goTypes = []any{
 	(*ConfigFile)(nil),                 // 0: plantr.config.v1.ConfigFile
-	(*GithubRelease)(nil),              // 1: plantr.config.v1.GithubRelease
-	(*SystemPackage)(nil),              // 2: plantr.config.v1.SystemPackage
-	(*GitRepo)(nil),                    // 3: plantr.config.v1.GitRepo
-	(*Golang)(nil),                     // 4: plantr.config.v1.Golang
-	(*GoInstall)(nil),                  // 5: plantr.config.v1.GoInstall
-	(*UrlDownload)(nil),                // 6: plantr.config.v1.UrlDownload
-	(*RoleGroup)(nil),                  // 7: plantr.config.v1.RoleGroup
-	(*Seed)(nil),                       // 8: plantr.config.v1.Seed
-	(*Role)(nil),                       // 9: plantr.config.v1.Role
-	(*Node)(nil),                       // 10: plantr.config.v1.Node
-	(*Config)(nil),                     // 11: plantr.config.v1.Config
-	(*GithubRelease_AssetPattern)(nil), // 12: plantr.config.v1.GithubRelease.AssetPattern
-	(*GithubRelease_AssetPattern_ArchPattern)(nil), // 13: plantr.config.v1.GithubRelease.AssetPattern.ArchPattern
-	(*SystemPackage_Apt)(nil),                      // 14: plantr.config.v1.SystemPackage.Apt
-	(*SystemPackage_Brew)(nil),                     // 15: plantr.config.v1.SystemPackage.Brew
-	(*SystemPackage_Pacman)(nil),                   // 16: plantr.config.v1.SystemPackage.Pacman
-	(*UrlDownload_OsGroup)(nil),                    // 17: plantr.config.v1.UrlDownload.OsGroup
-	(*UrlDownload_OsGroup_ArchGroup)(nil),          // 18: plantr.config.v1.UrlDownload.OsGroup.ArchGroup
-	(*Seed_Metadata)(nil),                          // 19: plantr.config.v1.Seed.Metadata
-	nil,                                            // 20: plantr.config.v1.Config.RolesEntry
+	(*Signature)(nil),                  // 1: plantr.config.v1.Signature
+	(*GithubRelease)(nil),              // 2: plantr.config.v1.GithubRelease
+	(*SystemPackage)(nil),              // 3: plantr.config.v1.SystemPackage
+	(*GitRepo)(nil),                    // 4: plantr.config.v1.GitRepo
+	(*Golang)(nil),                     // 5: plantr.config.v1.Golang
+	(*GoInstall)(nil),                  // 6: plantr.config.v1.GoInstall
+	(*UrlDownload)(nil),                // 7: plantr.config.v1.UrlDownload
+	(*RoleGroup)(nil),                  // 8: plantr.config.v1.RoleGroup
+	(*Seed)(nil),                       // 9: plantr.config.v1.Seed
+	(*Role)(nil),                       // 10: plantr.config.v1.Role
+	(*Node)(nil),                       // 11: plantr.config.v1.Node
+	(*Config)(nil),                     // 12: plantr.config.v1.Config
+	(*GithubRelease_AssetPattern)(nil), // 13: plantr.config.v1.GithubRelease.AssetPattern
+	(*GithubRelease_AssetPattern_ArchPattern)(nil), // 14: plantr.config.v1.GithubRelease.AssetPattern.ArchPattern
+	(*SystemPackage_Apt)(nil),                      // 15: plantr.config.v1.SystemPackage.Apt
+	(*SystemPackage_Brew)(nil),                     // 16: plantr.config.v1.SystemPackage.Brew
+	(*SystemPackage_Pacman)(nil),                   // 17: plantr.config.v1.SystemPackage.Pacman
+	(*UrlDownload_OsGroup)(nil),                    // 18: plantr.config.v1.UrlDownload.OsGroup
+	(*UrlDownload_OsGroup_ArchGroup)(nil),          // 19: plantr.config.v1.UrlDownload.OsGroup.ArchGroup
+	(*Seed_Metadata)(nil),                          // 20: plantr.config.v1.Seed.Metadata
+	nil,                                            // 21: plantr.config.v1.Config.RolesEntry
 }
 var file_plantr_config_v1_struct_proto_depIdxs = []int32{
-	12, // 0: plantr.config.v1.GithubRelease.asset_patterns:type_name -> plantr.config.v1.GithubRelease.AssetPattern
-	14, // 1: plantr.config.v1.SystemPackage.apt:type_name -> plantr.config.v1.SystemPackage.Apt
-	15, // 2: plantr.config.v1.SystemPackage.brew:type_name -> plantr.config.v1.SystemPackage.Brew
-	16, // 3: plantr.config.v1.SystemPackage.pacman:type_name -> plantr.config.v1.SystemPackage.Pacman
-	17, // 4: plantr.config.v1.UrlDownload.urls:type_name -> plantr.config.v1.UrlDownload.OsGroup
-	19, // 5: plantr.config.v1.Seed.meta:type_name -> plantr.config.v1.Seed.Metadata
-	0,  // 6: plantr.config.v1.Seed.config_file:type_name -> plantr.config.v1.ConfigFile
-	1,  // 7: plantr.config.v1.Seed.github_release:type_name -> plantr.config.v1.GithubRelease
-	2,  // 8: plantr.config.v1.Seed.system_package:type_name -> plantr.config.v1.SystemPackage
-	3,  // 9: plantr.config.v1.Seed.git_repo:type_name -> plantr.config.v1.GitRepo
-	4,  // 10: plantr.config.v1.Seed.golang:type_name -> plantr.config.v1.Golang
-	5,  // 11: plantr.config.v1.Seed.go_install:type_name -> plantr.config.v1.GoInstall
-	6,  // 12: plantr.config.v1.Seed.url_download:type_name -> plantr.config.v1.UrlDownload
-	7,  // 13: plantr.config.v1.Seed.role_group:type_name -> plantr.config.v1.RoleGroup
-	8,  // 14: plantr.config.v1.Role.seeds:type_name -> plantr.config.v1.Seed
-	20, // 15: plantr.config.v1.Config.roles:type_name -> plantr.config.v1.Config.RolesEntry
-	10, // 16: plantr.config.v1.Config.nodes:type_name -> plantr.config.v1.Node
-	13, // 17: plantr.config.v1.GithubRelease.AssetPattern.linux:type_name -> plantr.config.v1.GithubRelease.AssetPattern.ArchPattern
-	13, // 18: plantr.config.v1.GithubRelease.AssetPattern.mac:type_name -> plantr.config.v1.GithubRelease.AssetPattern.ArchPattern
-	18, // 19: plantr.config.v1.UrlDownload.OsGroup.linux:type_name -> plantr.config.v1.UrlDownload.OsGroup.ArchGroup
-	18, // 20: plantr.config.v1.UrlDownload.OsGroup.mac:type_name -> plantr.config.v1.UrlDownload.OsGroup.ArchGroup
-	9,  // 21: plantr.config.v1.Config.RolesEntry.value:type_name -> plantr.config.v1.Role
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	13, // 0: plantr.config.v1.GithubRelease.asset_patterns:type_name -> plantr.config.v1.GithubRelease.AssetPattern
+	18, // 1: plantr.config.v1.GithubRelease.sha256:type_name -> plantr.config.v1.UrlDownload.OsGroup
+	1,  // 2: plantr.config.v1.GithubRelease.signature:type_name -> plantr.config.v1.Signature
+	15, // 3: plantr.config.v1.SystemPackage.apt:type_name -> plantr.config.v1.SystemPackage.Apt
+	16, // 4: plantr.config.v1.SystemPackage.brew:type_name -> plantr.config.v1.SystemPackage.Brew
+	17, // 5: plantr.config.v1.SystemPackage.pacman:type_name -> plantr.config.v1.SystemPackage.Pacman
+	18, // 6: plantr.config.v1.UrlDownload.urls:type_name -> plantr.config.v1.UrlDownload.OsGroup
+	18, // 7: plantr.config.v1.UrlDownload.sha256:type_name -> plantr.config.v1.UrlDownload.OsGroup
+	1,  // 8: plantr.config.v1.UrlDownload.signature:type_name -> plantr.config.v1.Signature
+	20, // 9: plantr.config.v1.Seed.meta:type_name -> plantr.config.v1.Seed.Metadata
+	0,  // 10: plantr.config.v1.Seed.config_file:type_name -> plantr.config.v1.ConfigFile
+	2,  // 11: plantr.config.v1.Seed.github_release:type_name -> plantr.config.v1.GithubRelease
+	3,  // 12: plantr.config.v1.Seed.system_package:type_name -> plantr.config.v1.SystemPackage
+	4,  // 13: plantr.config.v1.Seed.git_repo:type_name -> plantr.config.v1.GitRepo
+	5,  // 14: plantr.config.v1.Seed.golang:type_name -> plantr.config.v1.Golang
+	6,  // 15: plantr.config.v1.Seed.go_install:type_name -> plantr.config.v1.GoInstall
+	7,  // 16: plantr.config.v1.Seed.url_download:type_name -> plantr.config.v1.UrlDownload
+	8,  // 17: plantr.config.v1.Seed.role_group:type_name -> plantr.config.v1.RoleGroup
+	9,  // 18: plantr.config.v1.Role.seeds:type_name -> plantr.config.v1.Seed
+	21, // 19: plantr.config.v1.Config.roles:type_name -> plantr.config.v1.Config.RolesEntry
+	11, // 20: plantr.config.v1.Config.nodes:type_name -> plantr.config.v1.Node
+	14, // 21: plantr.config.v1.GithubRelease.AssetPattern.linux:type_name -> plantr.config.v1.GithubRelease.AssetPattern.ArchPattern
+	14, // 22: plantr.config.v1.GithubRelease.AssetPattern.mac:type_name -> plantr.config.v1.GithubRelease.AssetPattern.ArchPattern
+	19, // 23: plantr.config.v1.UrlDownload.OsGroup.linux:type_name -> plantr.config.v1.UrlDownload.OsGroup.ArchGroup
+	19, // 24: plantr.config.v1.UrlDownload.OsGroup.mac:type_name -> plantr.config.v1.UrlDownload.OsGroup.ArchGroup
+	10, // 25: plantr.config.v1.Config.RolesEntry.value:type_name -> plantr.config.v1.Role
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_plantr_config_v1_struct_proto_init() }
@@ -1714,7 +1967,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GithubRelease); i {
+			switch v := v.(*Signature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1726,7 +1979,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage); i {
+			switch v := v.(*GithubRelease); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1738,7 +1991,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GitRepo); i {
+			switch v := v.(*SystemPackage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1750,7 +2003,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Golang); i {
+			switch v := v.(*GitRepo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1762,7 +2015,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GoInstall); i {
+			switch v := v.(*Golang); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1774,7 +2027,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*UrlDownload); i {
+			switch v := v.(*GoInstall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1786,7 +2039,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*RoleGroup); i {
+			switch v := v.(*UrlDownload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1798,7 +2051,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Seed); i {
+			switch v := v.(*RoleGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1810,7 +2063,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*Role); i {
+			switch v := v.(*Seed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1822,7 +2075,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*Node); i {
+			switch v := v.(*Role); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1834,7 +2087,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*Config); i {
+			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1846,7 +2099,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GithubRelease_AssetPattern); i {
+			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1858,7 +2111,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GithubRelease_AssetPattern_ArchPattern); i {
+			switch v := v.(*GithubRelease_AssetPattern); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1870,7 +2123,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage_Apt); i {
+			switch v := v.(*GithubRelease_AssetPattern_ArchPattern); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1882,7 +2135,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage_Brew); i {
+			switch v := v.(*SystemPackage_Apt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1894,7 +2147,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage_Pacman); i {
+			switch v := v.(*SystemPackage_Brew); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,7 +2159,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*UrlDownload_OsGroup); i {
+			switch v := v.(*SystemPackage_Pacman); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1918,7 +2171,7 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*UrlDownload_OsGroup_ArchGroup); i {
+			switch v := v.(*UrlDownload_OsGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1930,6 +2183,18 @@ func file_plantr_config_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_config_v1_struct_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*UrlDownload_OsGroup_ArchGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_config_v1_struct_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*Seed_Metadata); i {
 			case 0:
 				return &v.state
@@ -1943,14 +2208,18 @@ func file_plantr_config_v1_struct_proto_init() {
 		}
 	}
 	file_plantr_config_v1_struct_proto_msgTypes[0].OneofWrappers = []any{}
-	file_plantr_config_v1_struct_proto_msgTypes[1].OneofWrappers = []any{}
-	file_plantr_config_v1_struct_proto_msgTypes[3].OneofWrappers = []any{
+	file_plantr_config_v1_struct_proto_msgTypes[1].OneofWrappers = []any{
+		(*Signature_Asset)(nil),
+		(*Signature_Url)(nil),
+	}
+	file_plantr_config_v1_struct_proto_msgTypes[2].OneofWrappers = []any{}
+	file_plantr_config_v1_struct_proto_msgTypes[4].OneofWrappers = []any{
 		(*GitRepo_Tag)(nil),
 		(*GitRepo_Commit)(nil),
 	}
-	file_plantr_config_v1_struct_proto_msgTypes[5].OneofWrappers = []any{}
 	file_plantr_config_v1_struct_proto_msgTypes[6].OneofWrappers = []any{}
-	file_plantr_config_v1_struct_proto_msgTypes[8].OneofWrappers = []any{
+	file_plantr_config_v1_struct_proto_msgTypes[7].OneofWrappers = []any{}
+	file_plantr_config_v1_struct_proto_msgTypes[9].OneofWrappers = []any{
 		(*Seed_ConfigFile)(nil),
 		(*Seed_GithubRelease)(nil),
 		(*Seed_SystemPackage)(nil),
@@ -1960,15 +2229,15 @@ func file_plantr_config_v1_struct_proto_init() {
 		(*Seed_UrlDownload)(nil),
 		(*Seed_RoleGroup)(nil),
 	}
-	file_plantr_config_v1_struct_proto_msgTypes[18].OneofWrappers = []any{}
 	file_plantr_config_v1_struct_proto_msgTypes[19].OneofWrappers = []any{}
+	file_plantr_config_v1_struct_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plantr_config_v1_struct_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -41,14 +41,58 @@ const (
 	// ControllerServiceForceRefreshProcedure is the fully-qualified name of the ControllerService's
 	// ForceRefresh RPC.
 	ControllerServiceForceRefreshProcedure = "/plantr.controller.v1.ControllerService/ForceRefresh"
+	// ControllerServiceReportSyncProcedure is the fully-qualified name of the ControllerService's
+	// ReportSync RPC.
+	ControllerServiceReportSyncProcedure = "/plantr.controller.v1.ControllerService/ReportSync"
+	// ControllerServiceListNodesProcedure is the fully-qualified name of the ControllerService's
+	// ListNodes RPC.
+	ControllerServiceListNodesProcedure = "/plantr.controller.v1.ControllerService/ListNodes"
+	// ControllerServiceGetNodeStatusProcedure is the fully-qualified name of the ControllerService's
+	// GetNodeStatus RPC.
+	ControllerServiceGetNodeStatusProcedure = "/plantr.controller.v1.ControllerService/GetNodeStatus"
+	// ControllerServiceIssueAgentTokenProcedure is the fully-qualified name of the ControllerService's
+	// IssueAgentToken RPC.
+	ControllerServiceIssueAgentTokenProcedure = "/plantr.controller.v1.ControllerService/IssueAgentToken"
+	// ControllerServiceListOutdatedProcedure is the fully-qualified name of the ControllerService's
+	// ListOutdated RPC.
+	ControllerServiceListOutdatedProcedure = "/plantr.controller.v1.ControllerService/ListOutdated"
+	// ControllerServiceGetGithubRateLimitsProcedure is the fully-qualified name of the
+	// ControllerService's GetGithubRateLimits RPC.
+	ControllerServiceGetGithubRateLimitsProcedure = "/plantr.controller.v1.ControllerService/GetGithubRateLimits"
+	// ControllerServicePromoteChannelProcedure is the fully-qualified name of the ControllerService's
+	// PromoteChannel RPC.
+	ControllerServicePromoteChannelProcedure = "/plantr.controller.v1.ControllerService/PromoteChannel"
+	// ControllerServiceResetChannelProcedure is the fully-qualified name of the ControllerService's
+	// ResetChannel RPC.
+	ControllerServiceResetChannelProcedure = "/plantr.controller.v1.ControllerService/ResetChannel"
+	// ControllerServiceListConfigRevisionsProcedure is the fully-qualified name of the
+	// ControllerService's ListConfigRevisions RPC.
+	ControllerServiceListConfigRevisionsProcedure = "/plantr.controller.v1.ControllerService/ListConfigRevisions"
+	// ControllerServicePinConfigProcedure is the fully-qualified name of the ControllerService's
+	// PinConfig RPC.
+	ControllerServicePinConfigProcedure = "/plantr.controller.v1.ControllerService/PinConfig"
+	// ControllerServiceUnpinConfigProcedure is the fully-qualified name of the ControllerService's
+	// UnpinConfig RPC.
+	ControllerServiceUnpinConfigProcedure = "/plantr.controller.v1.ControllerService/UnpinConfig"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	controllerServiceServiceDescriptor            = v1.File_plantr_controller_v1_service_proto.Services().ByName("ControllerService")
-	controllerServiceLoginMethodDescriptor        = controllerServiceServiceDescriptor.Methods().ByName("Login")
-	controllerServiceGetSyncDataMethodDescriptor  = controllerServiceServiceDescriptor.Methods().ByName("GetSyncData")
-	controllerServiceForceRefreshMethodDescriptor = controllerServiceServiceDescriptor.Methods().ByName("ForceRefresh")
+	controllerServiceServiceDescriptor                   = v1.File_plantr_controller_v1_service_proto.Services().ByName("ControllerService")
+	controllerServiceLoginMethodDescriptor               = controllerServiceServiceDescriptor.Methods().ByName("Login")
+	controllerServiceGetSyncDataMethodDescriptor         = controllerServiceServiceDescriptor.Methods().ByName("GetSyncData")
+	controllerServiceForceRefreshMethodDescriptor        = controllerServiceServiceDescriptor.Methods().ByName("ForceRefresh")
+	controllerServiceReportSyncMethodDescriptor          = controllerServiceServiceDescriptor.Methods().ByName("ReportSync")
+	controllerServiceListNodesMethodDescriptor           = controllerServiceServiceDescriptor.Methods().ByName("ListNodes")
+	controllerServiceGetNodeStatusMethodDescriptor       = controllerServiceServiceDescriptor.Methods().ByName("GetNodeStatus")
+	controllerServiceIssueAgentTokenMethodDescriptor     = controllerServiceServiceDescriptor.Methods().ByName("IssueAgentToken")
+	controllerServiceListOutdatedMethodDescriptor        = controllerServiceServiceDescriptor.Methods().ByName("ListOutdated")
+	controllerServiceGetGithubRateLimitsMethodDescriptor = controllerServiceServiceDescriptor.Methods().ByName("GetGithubRateLimits")
+	controllerServicePromoteChannelMethodDescriptor      = controllerServiceServiceDescriptor.Methods().ByName("PromoteChannel")
+	controllerServiceResetChannelMethodDescriptor        = controllerServiceServiceDescriptor.Methods().ByName("ResetChannel")
+	controllerServiceListConfigRevisionsMethodDescriptor = controllerServiceServiceDescriptor.Methods().ByName("ListConfigRevisions")
+	controllerServicePinConfigMethodDescriptor           = controllerServiceServiceDescriptor.Methods().ByName("PinConfig")
+	controllerServiceUnpinConfigMethodDescriptor         = controllerServiceServiceDescriptor.Methods().ByName("UnpinConfig")
 )
 
 // ControllerServiceClient is a client for the plantr.controller.v1.ControllerService service.
@@ -56,6 +100,17 @@ type ControllerServiceClient interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	GetSyncData(context.Context, *connect.Request[v1.GetSyncDataRequest]) (*connect.Response[v1.GetSyncDataResponse], error)
 	ForceRefresh(context.Context, *connect.Request[v1.ForceRefreshRequest]) (*connect.Response[v1.ForceRefreshResponse], error)
+	ReportSync(context.Context, *connect.Request[v1.ReportSyncRequest]) (*connect.Response[v1.ReportSyncResponse], error)
+	ListNodes(context.Context, *connect.Request[v1.ListNodesRequest]) (*connect.Response[v1.ListNodesResponse], error)
+	GetNodeStatus(context.Context, *connect.Request[v1.GetNodeStatusRequest]) (*connect.Response[v1.GetNodeStatusResponse], error)
+	IssueAgentToken(context.Context, *connect.Request[v1.IssueAgentTokenRequest]) (*connect.Response[v1.IssueAgentTokenResponse], error)
+	ListOutdated(context.Context, *connect.Request[v1.ListOutdatedRequest]) (*connect.Response[v1.ListOutdatedResponse], error)
+	GetGithubRateLimits(context.Context, *connect.Request[v1.GetGithubRateLimitsRequest]) (*connect.Response[v1.GetGithubRateLimitsResponse], error)
+	PromoteChannel(context.Context, *connect.Request[v1.PromoteChannelRequest]) (*connect.Response[v1.PromoteChannelResponse], error)
+	ResetChannel(context.Context, *connect.Request[v1.ResetChannelRequest]) (*connect.Response[v1.ResetChannelResponse], error)
+	ListConfigRevisions(context.Context, *connect.Request[v1.ListConfigRevisionsRequest]) (*connect.Response[v1.ListConfigRevisionsResponse], error)
+	PinConfig(context.Context, *connect.Request[v1.PinConfigRequest]) (*connect.Response[v1.PinConfigResponse], error)
+	UnpinConfig(context.Context, *connect.Request[v1.UnpinConfigRequest]) (*connect.Response[v1.UnpinConfigResponse], error)
 }
 
 // NewControllerServiceClient constructs a client for the plantr.controller.v1.ControllerService
@@ -86,14 +141,91 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(controllerServiceForceRefreshMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		reportSync: connect.NewClient[v1.ReportSyncRequest, v1.ReportSyncResponse](
+			httpClient,
+			baseURL+ControllerServiceReportSyncProcedure,
+			connect.WithSchema(controllerServiceReportSyncMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listNodes: connect.NewClient[v1.ListNodesRequest, v1.ListNodesResponse](
+			httpClient,
+			baseURL+ControllerServiceListNodesProcedure,
+			connect.WithSchema(controllerServiceListNodesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getNodeStatus: connect.NewClient[v1.GetNodeStatusRequest, v1.GetNodeStatusResponse](
+			httpClient,
+			baseURL+ControllerServiceGetNodeStatusProcedure,
+			connect.WithSchema(controllerServiceGetNodeStatusMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		issueAgentToken: connect.NewClient[v1.IssueAgentTokenRequest, v1.IssueAgentTokenResponse](
+			httpClient,
+			baseURL+ControllerServiceIssueAgentTokenProcedure,
+			connect.WithSchema(controllerServiceIssueAgentTokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listOutdated: connect.NewClient[v1.ListOutdatedRequest, v1.ListOutdatedResponse](
+			httpClient,
+			baseURL+ControllerServiceListOutdatedProcedure,
+			connect.WithSchema(controllerServiceListOutdatedMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getGithubRateLimits: connect.NewClient[v1.GetGithubRateLimitsRequest, v1.GetGithubRateLimitsResponse](
+			httpClient,
+			baseURL+ControllerServiceGetGithubRateLimitsProcedure,
+			connect.WithSchema(controllerServiceGetGithubRateLimitsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		promoteChannel: connect.NewClient[v1.PromoteChannelRequest, v1.PromoteChannelResponse](
+			httpClient,
+			baseURL+ControllerServicePromoteChannelProcedure,
+			connect.WithSchema(controllerServicePromoteChannelMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resetChannel: connect.NewClient[v1.ResetChannelRequest, v1.ResetChannelResponse](
+			httpClient,
+			baseURL+ControllerServiceResetChannelProcedure,
+			connect.WithSchema(controllerServiceResetChannelMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listConfigRevisions: connect.NewClient[v1.ListConfigRevisionsRequest, v1.ListConfigRevisionsResponse](
+			httpClient,
+			baseURL+ControllerServiceListConfigRevisionsProcedure,
+			connect.WithSchema(controllerServiceListConfigRevisionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		pinConfig: connect.NewClient[v1.PinConfigRequest, v1.PinConfigResponse](
+			httpClient,
+			baseURL+ControllerServicePinConfigProcedure,
+			connect.WithSchema(controllerServicePinConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unpinConfig: connect.NewClient[v1.UnpinConfigRequest, v1.UnpinConfigResponse](
+			httpClient,
+			baseURL+ControllerServiceUnpinConfigProcedure,
+			connect.WithSchema(controllerServiceUnpinConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // controllerServiceClient implements ControllerServiceClient.
 type controllerServiceClient struct {
-	login        *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	getSyncData  *connect.Client[v1.GetSyncDataRequest, v1.GetSyncDataResponse]
-	forceRefresh *connect.Client[v1.ForceRefreshRequest, v1.ForceRefreshResponse]
+	login               *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	getSyncData         *connect.Client[v1.GetSyncDataRequest, v1.GetSyncDataResponse]
+	forceRefresh        *connect.Client[v1.ForceRefreshRequest, v1.ForceRefreshResponse]
+	reportSync          *connect.Client[v1.ReportSyncRequest, v1.ReportSyncResponse]
+	listNodes           *connect.Client[v1.ListNodesRequest, v1.ListNodesResponse]
+	getNodeStatus       *connect.Client[v1.GetNodeStatusRequest, v1.GetNodeStatusResponse]
+	issueAgentToken     *connect.Client[v1.IssueAgentTokenRequest, v1.IssueAgentTokenResponse]
+	listOutdated        *connect.Client[v1.ListOutdatedRequest, v1.ListOutdatedResponse]
+	getGithubRateLimits *connect.Client[v1.GetGithubRateLimitsRequest, v1.GetGithubRateLimitsResponse]
+	promoteChannel      *connect.Client[v1.PromoteChannelRequest, v1.PromoteChannelResponse]
+	resetChannel        *connect.Client[v1.ResetChannelRequest, v1.ResetChannelResponse]
+	listConfigRevisions *connect.Client[v1.ListConfigRevisionsRequest, v1.ListConfigRevisionsResponse]
+	pinConfig           *connect.Client[v1.PinConfigRequest, v1.PinConfigResponse]
+	unpinConfig         *connect.Client[v1.UnpinConfigRequest, v1.UnpinConfigResponse]
 }
 
 // Login calls plantr.controller.v1.ControllerService.Login.
@@ -111,12 +243,78 @@ func (c *controllerServiceClient) ForceRefresh(ctx context.Context, req *connect
 	return c.forceRefresh.CallUnary(ctx, req)
 }
 
+// ReportSync calls plantr.controller.v1.ControllerService.ReportSync.
+func (c *controllerServiceClient) ReportSync(ctx context.Context, req *connect.Request[v1.ReportSyncRequest]) (*connect.Response[v1.ReportSyncResponse], error) {
+	return c.reportSync.CallUnary(ctx, req)
+}
+
+// ListNodes calls plantr.controller.v1.ControllerService.ListNodes.
+func (c *controllerServiceClient) ListNodes(ctx context.Context, req *connect.Request[v1.ListNodesRequest]) (*connect.Response[v1.ListNodesResponse], error) {
+	return c.listNodes.CallUnary(ctx, req)
+}
+
+// GetNodeStatus calls plantr.controller.v1.ControllerService.GetNodeStatus.
+func (c *controllerServiceClient) GetNodeStatus(ctx context.Context, req *connect.Request[v1.GetNodeStatusRequest]) (*connect.Response[v1.GetNodeStatusResponse], error) {
+	return c.getNodeStatus.CallUnary(ctx, req)
+}
+
+// IssueAgentToken calls plantr.controller.v1.ControllerService.IssueAgentToken.
+func (c *controllerServiceClient) IssueAgentToken(ctx context.Context, req *connect.Request[v1.IssueAgentTokenRequest]) (*connect.Response[v1.IssueAgentTokenResponse], error) {
+	return c.issueAgentToken.CallUnary(ctx, req)
+}
+
+// ListOutdated calls plantr.controller.v1.ControllerService.ListOutdated.
+func (c *controllerServiceClient) ListOutdated(ctx context.Context, req *connect.Request[v1.ListOutdatedRequest]) (*connect.Response[v1.ListOutdatedResponse], error) {
+	return c.listOutdated.CallUnary(ctx, req)
+}
+
+// GetGithubRateLimits calls plantr.controller.v1.ControllerService.GetGithubRateLimits.
+func (c *controllerServiceClient) GetGithubRateLimits(ctx context.Context, req *connect.Request[v1.GetGithubRateLimitsRequest]) (*connect.Response[v1.GetGithubRateLimitsResponse], error) {
+	return c.getGithubRateLimits.CallUnary(ctx, req)
+}
+
+// PromoteChannel calls plantr.controller.v1.ControllerService.PromoteChannel.
+func (c *controllerServiceClient) PromoteChannel(ctx context.Context, req *connect.Request[v1.PromoteChannelRequest]) (*connect.Response[v1.PromoteChannelResponse], error) {
+	return c.promoteChannel.CallUnary(ctx, req)
+}
+
+// ResetChannel calls plantr.controller.v1.ControllerService.ResetChannel.
+func (c *controllerServiceClient) ResetChannel(ctx context.Context, req *connect.Request[v1.ResetChannelRequest]) (*connect.Response[v1.ResetChannelResponse], error) {
+	return c.resetChannel.CallUnary(ctx, req)
+}
+
+// ListConfigRevisions calls plantr.controller.v1.ControllerService.ListConfigRevisions.
+func (c *controllerServiceClient) ListConfigRevisions(ctx context.Context, req *connect.Request[v1.ListConfigRevisionsRequest]) (*connect.Response[v1.ListConfigRevisionsResponse], error) {
+	return c.listConfigRevisions.CallUnary(ctx, req)
+}
+
+// PinConfig calls plantr.controller.v1.ControllerService.PinConfig.
+func (c *controllerServiceClient) PinConfig(ctx context.Context, req *connect.Request[v1.PinConfigRequest]) (*connect.Response[v1.PinConfigResponse], error) {
+	return c.pinConfig.CallUnary(ctx, req)
+}
+
+// UnpinConfig calls plantr.controller.v1.ControllerService.UnpinConfig.
+func (c *controllerServiceClient) UnpinConfig(ctx context.Context, req *connect.Request[v1.UnpinConfigRequest]) (*connect.Response[v1.UnpinConfigResponse], error) {
+	return c.unpinConfig.CallUnary(ctx, req)
+}
+
 // ControllerServiceHandler is an implementation of the plantr.controller.v1.ControllerService
 // service.
 type ControllerServiceHandler interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	GetSyncData(context.Context, *connect.Request[v1.GetSyncDataRequest]) (*connect.Response[v1.GetSyncDataResponse], error)
 	ForceRefresh(context.Context, *connect.Request[v1.ForceRefreshRequest]) (*connect.Response[v1.ForceRefreshResponse], error)
+	ReportSync(context.Context, *connect.Request[v1.ReportSyncRequest]) (*connect.Response[v1.ReportSyncResponse], error)
+	ListNodes(context.Context, *connect.Request[v1.ListNodesRequest]) (*connect.Response[v1.ListNodesResponse], error)
+	GetNodeStatus(context.Context, *connect.Request[v1.GetNodeStatusRequest]) (*connect.Response[v1.GetNodeStatusResponse], error)
+	IssueAgentToken(context.Context, *connect.Request[v1.IssueAgentTokenRequest]) (*connect.Response[v1.IssueAgentTokenResponse], error)
+	ListOutdated(context.Context, *connect.Request[v1.ListOutdatedRequest]) (*connect.Response[v1.ListOutdatedResponse], error)
+	GetGithubRateLimits(context.Context, *connect.Request[v1.GetGithubRateLimitsRequest]) (*connect.Response[v1.GetGithubRateLimitsResponse], error)
+	PromoteChannel(context.Context, *connect.Request[v1.PromoteChannelRequest]) (*connect.Response[v1.PromoteChannelResponse], error)
+	ResetChannel(context.Context, *connect.Request[v1.ResetChannelRequest]) (*connect.Response[v1.ResetChannelResponse], error)
+	ListConfigRevisions(context.Context, *connect.Request[v1.ListConfigRevisionsRequest]) (*connect.Response[v1.ListConfigRevisionsResponse], error)
+	PinConfig(context.Context, *connect.Request[v1.PinConfigRequest]) (*connect.Response[v1.PinConfigResponse], error)
+	UnpinConfig(context.Context, *connect.Request[v1.UnpinConfigRequest]) (*connect.Response[v1.UnpinConfigResponse], error)
 }
 
 // NewControllerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -143,6 +341,72 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		connect.WithSchema(controllerServiceForceRefreshMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	controllerServiceReportSyncHandler := connect.NewUnaryHandler(
+		ControllerServiceReportSyncProcedure,
+		svc.ReportSync,
+		connect.WithSchema(controllerServiceReportSyncMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListNodesHandler := connect.NewUnaryHandler(
+		ControllerServiceListNodesProcedure,
+		svc.ListNodes,
+		connect.WithSchema(controllerServiceListNodesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetNodeStatusHandler := connect.NewUnaryHandler(
+		ControllerServiceGetNodeStatusProcedure,
+		svc.GetNodeStatus,
+		connect.WithSchema(controllerServiceGetNodeStatusMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceIssueAgentTokenHandler := connect.NewUnaryHandler(
+		ControllerServiceIssueAgentTokenProcedure,
+		svc.IssueAgentToken,
+		connect.WithSchema(controllerServiceIssueAgentTokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListOutdatedHandler := connect.NewUnaryHandler(
+		ControllerServiceListOutdatedProcedure,
+		svc.ListOutdated,
+		connect.WithSchema(controllerServiceListOutdatedMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetGithubRateLimitsHandler := connect.NewUnaryHandler(
+		ControllerServiceGetGithubRateLimitsProcedure,
+		svc.GetGithubRateLimits,
+		connect.WithSchema(controllerServiceGetGithubRateLimitsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServicePromoteChannelHandler := connect.NewUnaryHandler(
+		ControllerServicePromoteChannelProcedure,
+		svc.PromoteChannel,
+		connect.WithSchema(controllerServicePromoteChannelMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceResetChannelHandler := connect.NewUnaryHandler(
+		ControllerServiceResetChannelProcedure,
+		svc.ResetChannel,
+		connect.WithSchema(controllerServiceResetChannelMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListConfigRevisionsHandler := connect.NewUnaryHandler(
+		ControllerServiceListConfigRevisionsProcedure,
+		svc.ListConfigRevisions,
+		connect.WithSchema(controllerServiceListConfigRevisionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServicePinConfigHandler := connect.NewUnaryHandler(
+		ControllerServicePinConfigProcedure,
+		svc.PinConfig,
+		connect.WithSchema(controllerServicePinConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceUnpinConfigHandler := connect.NewUnaryHandler(
+		ControllerServiceUnpinConfigProcedure,
+		svc.UnpinConfig,
+		connect.WithSchema(controllerServiceUnpinConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/plantr.controller.v1.ControllerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ControllerServiceLoginProcedure:
@@ -151,6 +415,28 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceGetSyncDataHandler.ServeHTTP(w, r)
 		case ControllerServiceForceRefreshProcedure:
 			controllerServiceForceRefreshHandler.ServeHTTP(w, r)
+		case ControllerServiceReportSyncProcedure:
+			controllerServiceReportSyncHandler.ServeHTTP(w, r)
+		case ControllerServiceListNodesProcedure:
+			controllerServiceListNodesHandler.ServeHTTP(w, r)
+		case ControllerServiceGetNodeStatusProcedure:
+			controllerServiceGetNodeStatusHandler.ServeHTTP(w, r)
+		case ControllerServiceIssueAgentTokenProcedure:
+			controllerServiceIssueAgentTokenHandler.ServeHTTP(w, r)
+		case ControllerServiceListOutdatedProcedure:
+			controllerServiceListOutdatedHandler.ServeHTTP(w, r)
+		case ControllerServiceGetGithubRateLimitsProcedure:
+			controllerServiceGetGithubRateLimitsHandler.ServeHTTP(w, r)
+		case ControllerServicePromoteChannelProcedure:
+			controllerServicePromoteChannelHandler.ServeHTTP(w, r)
+		case ControllerServiceResetChannelProcedure:
+			controllerServiceResetChannelHandler.ServeHTTP(w, r)
+		case ControllerServiceListConfigRevisionsProcedure:
+			controllerServiceListConfigRevisionsHandler.ServeHTTP(w, r)
+		case ControllerServicePinConfigProcedure:
+			controllerServicePinConfigHandler.ServeHTTP(w, r)
+		case ControllerServiceUnpinConfigProcedure:
+			controllerServiceUnpinConfigHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +457,47 @@ func (UnimplementedControllerServiceHandler) GetSyncData(context.Context, *conne
 func (UnimplementedControllerServiceHandler) ForceRefresh(context.Context, *connect.Request[v1.ForceRefreshRequest]) (*connect.Response[v1.ForceRefreshResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.ForceRefresh is not implemented"))
 }
+
+func (UnimplementedControllerServiceHandler) ReportSync(context.Context, *connect.Request[v1.ReportSyncRequest]) (*connect.Response[v1.ReportSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.ReportSync is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListNodes(context.Context, *connect.Request[v1.ListNodesRequest]) (*connect.Response[v1.ListNodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.ListNodes is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetNodeStatus(context.Context, *connect.Request[v1.GetNodeStatusRequest]) (*connect.Response[v1.GetNodeStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.GetNodeStatus is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) IssueAgentToken(context.Context, *connect.Request[v1.IssueAgentTokenRequest]) (*connect.Response[v1.IssueAgentTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.IssueAgentToken is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListOutdated(context.Context, *connect.Request[v1.ListOutdatedRequest]) (*connect.Response[v1.ListOutdatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.ListOutdated is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetGithubRateLimits(context.Context, *connect.Request[v1.GetGithubRateLimitsRequest]) (*connect.Response[v1.GetGithubRateLimitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.GetGithubRateLimits is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PromoteChannel(context.Context, *connect.Request[v1.PromoteChannelRequest]) (*connect.Response[v1.PromoteChannelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.PromoteChannel is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ResetChannel(context.Context, *connect.Request[v1.ResetChannelRequest]) (*connect.Response[v1.ResetChannelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.ResetChannel is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListConfigRevisions(context.Context, *connect.Request[v1.ListConfigRevisionsRequest]) (*connect.Response[v1.ListConfigRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.ListConfigRevisions is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PinConfig(context.Context, *connect.Request[v1.PinConfigRequest]) (*connect.Response[v1.PinConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.PinConfig is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) UnpinConfig(context.Context, *connect.Request[v1.UnpinConfigRequest]) (*connect.Response[v1.UnpinConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("plantr.controller.v1.ControllerService.UnpinConfig is not implemented"))
+}
@@ -156,6 +156,9 @@ type GetSyncDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// InventoryHashes are the hashes of seeds the agent has already applied, seeds matching these are not sent
+	InventoryHashes []string `protobuf:"bytes,1,rep,name=inventory_hashes,json=inventoryHashes,proto3" json:"inventory_hashes,omitempty"`
 }
 
 func (x *GetSyncDataRequest) Reset() {
@@ -190,12 +193,24 @@ func (*GetSyncDataRequest) Descriptor() ([]byte, []int) {
 	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *GetSyncDataRequest) GetInventoryHashes() []string {
+	if x != nil {
+		return x.InventoryHashes
+	}
+	return nil
+}
+
 type GetSyncDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Seeds are the new or changed seeds the agent should apply
 	Seeds []*Seed `protobuf:"bytes,1,rep,name=seeds,proto3" json:"seeds,omitempty"`
+	// Commit is the config repo commit the seeds were rendered from
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// RemovedHashes are the inventory hashes the agent holds that no longer match any seed for the node
+	RemovedHashes []string `protobuf:"bytes,3,rep,name=removed_hashes,json=removedHashes,proto3" json:"removed_hashes,omitempty"`
 }
 
 func (x *GetSyncDataResponse) Reset() {
@@ -237,6 +252,20 @@ func (x *GetSyncDataResponse) GetSeeds() []*Seed {
 	return nil
 }
 
+func (x *GetSyncDataResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *GetSyncDataResponse) GetRemovedHashes() []string {
+	if x != nil {
+		return x.RemovedHashes
+	}
+	return nil
+}
+
 type ForceRefreshRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -258,8 +287,1094 @@ func (x *ForceRefreshRequest) String() string {
 
 func (*ForceRefreshRequest) ProtoMessage() {}
 
-func (x *ForceRefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_service_proto_msgTypes[4]
+func (x *ForceRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRefreshRequest.ProtoReflect.Descriptor instead.
+func (*ForceRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+type ForceRefreshResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PushResults holds the outcome of syncing affected agents, empty when push sync is disabled
+	PushResults []*PushSyncResult `protobuf:"bytes,1,rep,name=push_results,json=pushResults,proto3" json:"push_results,omitempty"`
+}
+
+func (x *ForceRefreshResponse) Reset() {
+	*x = ForceRefreshResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceRefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceRefreshResponse) ProtoMessage() {}
+
+func (x *ForceRefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRefreshResponse.ProtoReflect.Descriptor instead.
+func (*ForceRefreshResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ForceRefreshResponse) GetPushResults() []*PushSyncResult {
+	if x != nil {
+		return x.PushResults
+	}
+	return nil
+}
+
+type ReportSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commit is the config repo commit the agent synced against
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Result is the overall outcome of the sync
+	Result SyncResult `protobuf:"varint,2,opt,name=result,proto3,enum=plantr.controller.v1.SyncResult" json:"result,omitempty"`
+	// FailingSeeds are the seeds that failed to execute during the sync
+	FailingSeeds []*SeedFailure `protobuf:"bytes,3,rep,name=failing_seeds,json=failingSeeds,proto3" json:"failing_seeds,omitempty"`
+}
+
+func (x *ReportSyncRequest) Reset() {
+	*x = ReportSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSyncRequest) ProtoMessage() {}
+
+func (x *ReportSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSyncRequest.ProtoReflect.Descriptor instead.
+func (*ReportSyncRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReportSyncRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ReportSyncRequest) GetResult() SyncResult {
+	if x != nil {
+		return x.Result
+	}
+	return SyncResult_SYNC_RESULT_UNSPECIFIED
+}
+
+func (x *ReportSyncRequest) GetFailingSeeds() []*SeedFailure {
+	if x != nil {
+		return x.FailingSeeds
+	}
+	return nil
+}
+
+type ReportSyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReportSyncResponse) Reset() {
+	*x = ReportSyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSyncResponse) ProtoMessage() {}
+
+func (x *ReportSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSyncResponse.ProtoReflect.Descriptor instead.
+func (*ReportSyncResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ListNodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListNodesRequest) Reset() {
+	*x = ListNodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesRequest) ProtoMessage() {}
+
+func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodesRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+type ListNodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*NodeStatus `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *ListNodesResponse) Reset() {
+	*x = ListNodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesResponse) ProtoMessage() {}
+
+func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodesResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListNodesResponse) GetNodes() []*NodeStatus {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type GetNodeStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// NodeID is the id of the node to get the status of
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *GetNodeStatusRequest) Reset() {
+	*x = GetNodeStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusRequest) ProtoMessage() {}
+
+func (x *GetNodeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetNodeStatusRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type GetNodeStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node *NodeStatus `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *GetNodeStatusResponse) Reset() {
+	*x = GetNodeStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusResponse) ProtoMessage() {}
+
+func (x *GetNodeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetNodeStatusResponse) GetNode() *NodeStatus {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type IssueAgentTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// NodeID is the id of the node whose agent the token is for
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *IssueAgentTokenRequest) Reset() {
+	*x = IssueAgentTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAgentTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAgentTokenRequest) ProtoMessage() {}
+
+func (x *IssueAgentTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAgentTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueAgentTokenRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IssueAgentTokenRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type IssueAgentTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Token is a short lived token the node's agent accepts as coming from the controller
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *IssueAgentTokenResponse) Reset() {
+	*x = IssueAgentTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAgentTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAgentTokenResponse) ProtoMessage() {}
+
+func (x *IssueAgentTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAgentTokenResponse.ProtoReflect.Descriptor instead.
+func (*IssueAgentTokenResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IssueAgentTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListOutdatedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOutdatedRequest) Reset() {
+	*x = ListOutdatedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutdatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutdatedRequest) ProtoMessage() {}
+
+func (x *ListOutdatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutdatedRequest.ProtoReflect.Descriptor instead.
+func (*ListOutdatedRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+type ListOutdatedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seeds are every pinned seed in the config, compared against the latest upstream version
+	Seeds []*OutdatedSeed `protobuf:"bytes,1,rep,name=seeds,proto3" json:"seeds,omitempty"`
+}
+
+func (x *ListOutdatedResponse) Reset() {
+	*x = ListOutdatedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutdatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutdatedResponse) ProtoMessage() {}
+
+func (x *ListOutdatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutdatedResponse.ProtoReflect.Descriptor instead.
+func (*ListOutdatedResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOutdatedResponse) GetSeeds() []*OutdatedSeed {
+	if x != nil {
+		return x.Seeds
+	}
+	return nil
+}
+
+type GetGithubRateLimitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetGithubRateLimitsRequest) Reset() {
+	*x = GetGithubRateLimitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGithubRateLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGithubRateLimitsRequest) ProtoMessage() {}
+
+func (x *GetGithubRateLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGithubRateLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetGithubRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+type GetGithubRateLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RateLimits are the last quota reported by each github host the controller has talked to
+	RateLimits []*GithubRateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+}
+
+func (x *GetGithubRateLimitsResponse) Reset() {
+	*x = GetGithubRateLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGithubRateLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGithubRateLimitsResponse) ProtoMessage() {}
+
+func (x *GetGithubRateLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGithubRateLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetGithubRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGithubRateLimitsResponse) GetRateLimits() []*GithubRateLimit {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+type PromoteChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// From is the channel whose current commit is promoted, empty for the default channel
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// To is the channel to pin to that commit
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *PromoteChannelRequest) Reset() {
+	*x = PromoteChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteChannelRequest) ProtoMessage() {}
+
+func (x *PromoteChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteChannelRequest.ProtoReflect.Descriptor instead.
+func (*PromoteChannelRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PromoteChannelRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PromoteChannelRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type PromoteChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commit is the config repo commit To is now pinned to
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// PushResults holds the outcome of syncing affected agents, empty when push sync is disabled
+	PushResults []*PushSyncResult `protobuf:"bytes,2,rep,name=push_results,json=pushResults,proto3" json:"push_results,omitempty"`
+}
+
+func (x *PromoteChannelResponse) Reset() {
+	*x = PromoteChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteChannelResponse) ProtoMessage() {}
+
+func (x *PromoteChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteChannelResponse.ProtoReflect.Descriptor instead.
+func (*PromoteChannelResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PromoteChannelResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *PromoteChannelResponse) GetPushResults() []*PushSyncResult {
+	if x != nil {
+		return x.PushResults
+	}
+	return nil
+}
+
+type ResetChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Channel to drop the promotion of, so it follows its own branch or commit again. The default channel is unpinned
+	// with UnpinConfig instead
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *ResetChannelRequest) Reset() {
+	*x = ResetChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetChannelRequest) ProtoMessage() {}
+
+func (x *ResetChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetChannelRequest.ProtoReflect.Descriptor instead.
+func (*ResetChannelRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResetChannelRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type ResetChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commit is the config repo commit the channel is now serving
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// PushResults holds the outcome of syncing affected agents, empty when push sync is disabled
+	PushResults []*PushSyncResult `protobuf:"bytes,2,rep,name=push_results,json=pushResults,proto3" json:"push_results,omitempty"`
+}
+
+func (x *ResetChannelResponse) Reset() {
+	*x = ResetChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetChannelResponse) ProtoMessage() {}
+
+func (x *ResetChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetChannelResponse.ProtoReflect.Descriptor instead.
+func (*ResetChannelResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResetChannelResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ResetChannelResponse) GetPushResults() []*PushSyncResult {
+	if x != nil {
+		return x.PushResults
+	}
+	return nil
+}
+
+type ListConfigRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Limit is the most revisions to return, defaults to 20
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListConfigRevisionsRequest) Reset() {
+	*x = ListConfigRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigRevisionsRequest) ProtoMessage() {}
+
+func (x *ListConfigRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListConfigRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListConfigRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Revisions are the commits the controller has loaded, newest first
+	Revisions []*ConfigRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// CurrentCommit is the commit the default channel is serving
+	CurrentCommit string `protobuf:"bytes,2,opt,name=current_commit,json=currentCommit,proto3" json:"current_commit,omitempty"`
+	// PinnedCommit is the commit the default channel is pinned to, empty if it isn't
+	PinnedCommit string `protobuf:"bytes,3,opt,name=pinned_commit,json=pinnedCommit,proto3" json:"pinned_commit,omitempty"`
+	// LastPoll is the outcome of the most recent background poll, unset if polling is disabled or hasn't run yet
+	LastPoll *ConfigPoll `protobuf:"bytes,4,opt,name=last_poll,json=lastPoll,proto3" json:"last_poll,omitempty"`
+}
+
+func (x *ListConfigRevisionsResponse) Reset() {
+	*x = ListConfigRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigRevisionsResponse) ProtoMessage() {}
+
+func (x *ListConfigRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListConfigRevisionsResponse) GetRevisions() []*ConfigRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListConfigRevisionsResponse) GetCurrentCommit() string {
+	if x != nil {
+		return x.CurrentCommit
+	}
+	return ""
+}
+
+func (x *ListConfigRevisionsResponse) GetPinnedCommit() string {
+	if x != nil {
+		return x.PinnedCommit
+	}
+	return ""
+}
+
+func (x *ListConfigRevisionsResponse) GetLastPoll() *ConfigPoll {
+	if x != nil {
+		return x.LastPoll
+	}
+	return nil
+}
+
+type PinConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commit is the config repo commit to pin to, either in full or a unique prefix of a loaded revision
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *PinConfigRequest) Reset() {
+	*x = PinConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinConfigRequest) ProtoMessage() {}
+
+func (x *PinConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinConfigRequest.ProtoReflect.Descriptor instead.
+func (*PinConfigRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PinConfigRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+type PinConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commit is the full commit now pinned
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// PushResults holds the outcome of syncing affected agents, empty when push sync is disabled
+	PushResults []*PushSyncResult `protobuf:"bytes,2,rep,name=push_results,json=pushResults,proto3" json:"push_results,omitempty"`
+}
+
+func (x *PinConfigResponse) Reset() {
+	*x = PinConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinConfigResponse) ProtoMessage() {}
+
+func (x *PinConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinConfigResponse.ProtoReflect.Descriptor instead.
+func (*PinConfigResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PinConfigResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *PinConfigResponse) GetPushResults() []*PushSyncResult {
+	if x != nil {
+		return x.PushResults
+	}
+	return nil
+}
+
+type UnpinConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinConfigRequest) Reset() {
+	*x = UnpinConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinConfigRequest) ProtoMessage() {}
+
+func (x *UnpinConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,34 +1385,39 @@ func (x *ForceRefreshRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceRefreshRequest.ProtoReflect.Descriptor instead.
-func (*ForceRefreshRequest) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UnpinConfigRequest.ProtoReflect.Descriptor instead.
+func (*UnpinConfigRequest) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{26}
 }
 
-type ForceRefreshResponse struct {
+type UnpinConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Commit is the latest commit, now being served again
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// PushResults holds the outcome of syncing affected agents, empty when push sync is disabled
+	PushResults []*PushSyncResult `protobuf:"bytes,2,rep,name=push_results,json=pushResults,proto3" json:"push_results,omitempty"`
 }
 
-func (x *ForceRefreshResponse) Reset() {
-	*x = ForceRefreshResponse{}
+func (x *UnpinConfigResponse) Reset() {
+	*x = UnpinConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_service_proto_msgTypes[5]
+		mi := &file_plantr_controller_v1_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ForceRefreshResponse) String() string {
+func (x *UnpinConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceRefreshResponse) ProtoMessage() {}
+func (*UnpinConfigResponse) ProtoMessage() {}
 
-func (x *ForceRefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_service_proto_msgTypes[5]
+func (x *UnpinConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,9 +1428,23 @@ func (x *ForceRefreshResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceRefreshResponse.ProtoReflect.Descriptor instead.
-func (*ForceRefreshResponse) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UnpinConfigResponse.ProtoReflect.Descriptor instead.
+func (*UnpinConfigResponse) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnpinConfigResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *UnpinConfigResponse) GetPushResults() []*PushSyncResult {
+	if x != nil {
+		return x.PushResults
+	}
+	return nil
 }
 
 var File_plantr_controller_v1_service_proto protoreflect.FileDescriptor
@@ -343,34 +1477,225 @@ var file_plantr_controller_v1_service_proto_rawDesc = []byte{
 	0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x68, 0x61, 0x6c,
 	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x65, 0x61,
 	0x6c, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x79,
-	0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x52,
-	0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a,
-	0x14, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb0, 0x02, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x05, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x52, 0x05, 0x73, 0x65, 0x65,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x70, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x46, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x65,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x0c, 0x66, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x65, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x22, 0x2f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x22, 0x31, 0x0a, 0x16, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x17, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x65, 0x64, 0x52, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x22, 0x1c, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x22, 0x3b, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22,
+	0x79, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x47, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x70,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x77, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x70,
+	0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x22, 0x2a, 0x0a, 0x10, 0x50, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x22, 0x74, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x47, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x70, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x6e, 0x70,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x76, 0x0a, 0x13, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x47,
+	0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0xbe, 0x0b, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a,
+	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0a, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
 	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x2e, 0x70,
-	0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
 	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x65, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70,
-	0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0f, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x30, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x30, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xe1, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d,
 	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
 	0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72,
@@ -401,29 +1726,94 @@ func file_plantr_controller_v1_service_proto_rawDescGZIP() []byte {
 	return file_plantr_controller_v1_service_proto_rawDescData
 }
 
-var file_plantr_controller_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_plantr_controller_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_plantr_controller_v1_service_proto_goTypes = []any{
-	(*LoginRequest)(nil),         // 0: plantr.controller.v1.LoginRequest
-	(*LoginResponse)(nil),        // 1: plantr.controller.v1.LoginResponse
-	(*GetSyncDataRequest)(nil),   // 2: plantr.controller.v1.GetSyncDataRequest
-	(*GetSyncDataResponse)(nil),  // 3: plantr.controller.v1.GetSyncDataResponse
-	(*ForceRefreshRequest)(nil),  // 4: plantr.controller.v1.ForceRefreshRequest
-	(*ForceRefreshResponse)(nil), // 5: plantr.controller.v1.ForceRefreshResponse
-	(*Seed)(nil),                 // 6: plantr.controller.v1.Seed
+	(*LoginRequest)(nil),                // 0: plantr.controller.v1.LoginRequest
+	(*LoginResponse)(nil),               // 1: plantr.controller.v1.LoginResponse
+	(*GetSyncDataRequest)(nil),          // 2: plantr.controller.v1.GetSyncDataRequest
+	(*GetSyncDataResponse)(nil),         // 3: plantr.controller.v1.GetSyncDataResponse
+	(*ForceRefreshRequest)(nil),         // 4: plantr.controller.v1.ForceRefreshRequest
+	(*ForceRefreshResponse)(nil),        // 5: plantr.controller.v1.ForceRefreshResponse
+	(*ReportSyncRequest)(nil),           // 6: plantr.controller.v1.ReportSyncRequest
+	(*ReportSyncResponse)(nil),          // 7: plantr.controller.v1.ReportSyncResponse
+	(*ListNodesRequest)(nil),            // 8: plantr.controller.v1.ListNodesRequest
+	(*ListNodesResponse)(nil),           // 9: plantr.controller.v1.ListNodesResponse
+	(*GetNodeStatusRequest)(nil),        // 10: plantr.controller.v1.GetNodeStatusRequest
+	(*GetNodeStatusResponse)(nil),       // 11: plantr.controller.v1.GetNodeStatusResponse
+	(*IssueAgentTokenRequest)(nil),      // 12: plantr.controller.v1.IssueAgentTokenRequest
+	(*IssueAgentTokenResponse)(nil),     // 13: plantr.controller.v1.IssueAgentTokenResponse
+	(*ListOutdatedRequest)(nil),         // 14: plantr.controller.v1.ListOutdatedRequest
+	(*ListOutdatedResponse)(nil),        // 15: plantr.controller.v1.ListOutdatedResponse
+	(*GetGithubRateLimitsRequest)(nil),  // 16: plantr.controller.v1.GetGithubRateLimitsRequest
+	(*GetGithubRateLimitsResponse)(nil), // 17: plantr.controller.v1.GetGithubRateLimitsResponse
+	(*PromoteChannelRequest)(nil),       // 18: plantr.controller.v1.PromoteChannelRequest
+	(*PromoteChannelResponse)(nil),      // 19: plantr.controller.v1.PromoteChannelResponse
+	(*ResetChannelRequest)(nil),         // 20: plantr.controller.v1.ResetChannelRequest
+	(*ResetChannelResponse)(nil),        // 21: plantr.controller.v1.ResetChannelResponse
+	(*ListConfigRevisionsRequest)(nil),  // 22: plantr.controller.v1.ListConfigRevisionsRequest
+	(*ListConfigRevisionsResponse)(nil), // 23: plantr.controller.v1.ListConfigRevisionsResponse
+	(*PinConfigRequest)(nil),            // 24: plantr.controller.v1.PinConfigRequest
+	(*PinConfigResponse)(nil),           // 25: plantr.controller.v1.PinConfigResponse
+	(*UnpinConfigRequest)(nil),          // 26: plantr.controller.v1.UnpinConfigRequest
+	(*UnpinConfigResponse)(nil),         // 27: plantr.controller.v1.UnpinConfigResponse
+	(*Seed)(nil),                        // 28: plantr.controller.v1.Seed
+	(*PushSyncResult)(nil),              // 29: plantr.controller.v1.PushSyncResult
+	(SyncResult)(0),                     // 30: plantr.controller.v1.SyncResult
+	(*SeedFailure)(nil),                 // 31: plantr.controller.v1.SeedFailure
+	(*NodeStatus)(nil),                  // 32: plantr.controller.v1.NodeStatus
+	(*OutdatedSeed)(nil),                // 33: plantr.controller.v1.OutdatedSeed
+	(*GithubRateLimit)(nil),             // 34: plantr.controller.v1.GithubRateLimit
+	(*ConfigRevision)(nil),              // 35: plantr.controller.v1.ConfigRevision
+	(*ConfigPoll)(nil),                  // 36: plantr.controller.v1.ConfigPoll
 }
 var file_plantr_controller_v1_service_proto_depIdxs = []int32{
-	6, // 0: plantr.controller.v1.GetSyncDataResponse.seeds:type_name -> plantr.controller.v1.Seed
-	0, // 1: plantr.controller.v1.ControllerService.Login:input_type -> plantr.controller.v1.LoginRequest
-	2, // 2: plantr.controller.v1.ControllerService.GetSyncData:input_type -> plantr.controller.v1.GetSyncDataRequest
-	4, // 3: plantr.controller.v1.ControllerService.ForceRefresh:input_type -> plantr.controller.v1.ForceRefreshRequest
-	1, // 4: plantr.controller.v1.ControllerService.Login:output_type -> plantr.controller.v1.LoginResponse
-	3, // 5: plantr.controller.v1.ControllerService.GetSyncData:output_type -> plantr.controller.v1.GetSyncDataResponse
-	5, // 6: plantr.controller.v1.ControllerService.ForceRefresh:output_type -> plantr.controller.v1.ForceRefreshResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	28, // 0: plantr.controller.v1.GetSyncDataResponse.seeds:type_name -> plantr.controller.v1.Seed
+	29, // 1: plantr.controller.v1.ForceRefreshResponse.push_results:type_name -> plantr.controller.v1.PushSyncResult
+	30, // 2: plantr.controller.v1.ReportSyncRequest.result:type_name -> plantr.controller.v1.SyncResult
+	31, // 3: plantr.controller.v1.ReportSyncRequest.failing_seeds:type_name -> plantr.controller.v1.SeedFailure
+	32, // 4: plantr.controller.v1.ListNodesResponse.nodes:type_name -> plantr.controller.v1.NodeStatus
+	32, // 5: plantr.controller.v1.GetNodeStatusResponse.node:type_name -> plantr.controller.v1.NodeStatus
+	33, // 6: plantr.controller.v1.ListOutdatedResponse.seeds:type_name -> plantr.controller.v1.OutdatedSeed
+	34, // 7: plantr.controller.v1.GetGithubRateLimitsResponse.rate_limits:type_name -> plantr.controller.v1.GithubRateLimit
+	29, // 8: plantr.controller.v1.PromoteChannelResponse.push_results:type_name -> plantr.controller.v1.PushSyncResult
+	29, // 9: plantr.controller.v1.ResetChannelResponse.push_results:type_name -> plantr.controller.v1.PushSyncResult
+	35, // 10: plantr.controller.v1.ListConfigRevisionsResponse.revisions:type_name -> plantr.controller.v1.ConfigRevision
+	36, // 11: plantr.controller.v1.ListConfigRevisionsResponse.last_poll:type_name -> plantr.controller.v1.ConfigPoll
+	29, // 12: plantr.controller.v1.PinConfigResponse.push_results:type_name -> plantr.controller.v1.PushSyncResult
+	29, // 13: plantr.controller.v1.UnpinConfigResponse.push_results:type_name -> plantr.controller.v1.PushSyncResult
+	0,  // 14: plantr.controller.v1.ControllerService.Login:input_type -> plantr.controller.v1.LoginRequest
+	2,  // 15: plantr.controller.v1.ControllerService.GetSyncData:input_type -> plantr.controller.v1.GetSyncDataRequest
+	4,  // 16: plantr.controller.v1.ControllerService.ForceRefresh:input_type -> plantr.controller.v1.ForceRefreshRequest
+	6,  // 17: plantr.controller.v1.ControllerService.ReportSync:input_type -> plantr.controller.v1.ReportSyncRequest
+	8,  // 18: plantr.controller.v1.ControllerService.ListNodes:input_type -> plantr.controller.v1.ListNodesRequest
+	10, // 19: plantr.controller.v1.ControllerService.GetNodeStatus:input_type -> plantr.controller.v1.GetNodeStatusRequest
+	12, // 20: plantr.controller.v1.ControllerService.IssueAgentToken:input_type -> plantr.controller.v1.IssueAgentTokenRequest
+	14, // 21: plantr.controller.v1.ControllerService.ListOutdated:input_type -> plantr.controller.v1.ListOutdatedRequest
+	16, // 22: plantr.controller.v1.ControllerService.GetGithubRateLimits:input_type -> plantr.controller.v1.GetGithubRateLimitsRequest
+	18, // 23: plantr.controller.v1.ControllerService.PromoteChannel:input_type -> plantr.controller.v1.PromoteChannelRequest
+	20, // 24: plantr.controller.v1.ControllerService.ResetChannel:input_type -> plantr.controller.v1.ResetChannelRequest
+	22, // 25: plantr.controller.v1.ControllerService.ListConfigRevisions:input_type -> plantr.controller.v1.ListConfigRevisionsRequest
+	24, // 26: plantr.controller.v1.ControllerService.PinConfig:input_type -> plantr.controller.v1.PinConfigRequest
+	26, // 27: plantr.controller.v1.ControllerService.UnpinConfig:input_type -> plantr.controller.v1.UnpinConfigRequest
+	1,  // 28: plantr.controller.v1.ControllerService.Login:output_type -> plantr.controller.v1.LoginResponse
+	3,  // 29: plantr.controller.v1.ControllerService.GetSyncData:output_type -> plantr.controller.v1.GetSyncDataResponse
+	5,  // 30: plantr.controller.v1.ControllerService.ForceRefresh:output_type -> plantr.controller.v1.ForceRefreshResponse
+	7,  // 31: plantr.controller.v1.ControllerService.ReportSync:output_type -> plantr.controller.v1.ReportSyncResponse
+	9,  // 32: plantr.controller.v1.ControllerService.ListNodes:output_type -> plantr.controller.v1.ListNodesResponse
+	11, // 33: plantr.controller.v1.ControllerService.GetNodeStatus:output_type -> plantr.controller.v1.GetNodeStatusResponse
+	13, // 34: plantr.controller.v1.ControllerService.IssueAgentToken:output_type -> plantr.controller.v1.IssueAgentTokenResponse
+	15, // 35: plantr.controller.v1.ControllerService.ListOutdated:output_type -> plantr.controller.v1.ListOutdatedResponse
+	17, // 36: plantr.controller.v1.ControllerService.GetGithubRateLimits:output_type -> plantr.controller.v1.GetGithubRateLimitsResponse
+	19, // 37: plantr.controller.v1.ControllerService.PromoteChannel:output_type -> plantr.controller.v1.PromoteChannelResponse
+	21, // 38: plantr.controller.v1.ControllerService.ResetChannel:output_type -> plantr.controller.v1.ResetChannelResponse
+	23, // 39: plantr.controller.v1.ControllerService.ListConfigRevisions:output_type -> plantr.controller.v1.ListConfigRevisionsResponse
+	25, // 40: plantr.controller.v1.ControllerService.PinConfig:output_type -> plantr.controller.v1.PinConfigResponse
+	27, // 41: plantr.controller.v1.ControllerService.UnpinConfig:output_type -> plantr.controller.v1.UnpinConfigResponse
+	28, // [28:42] is the sub-list for method output_type
+	14, // [14:28] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_plantr_controller_v1_service_proto_init() }
@@ -505,6 +1895,270 @@ func file_plantr_controller_v1_service_proto_init() {
 				return nil
 			}
 		}
+		file_plantr_controller_v1_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportSyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ListNodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListNodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNodeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNodeStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*IssueAgentTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*IssueAgentTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOutdatedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOutdatedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGithubRateLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGithubRateLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*PromoteChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*PromoteChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ListConfigRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ListConfigRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*PinConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*PinConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*UnpinConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_service_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*UnpinConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_plantr_controller_v1_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_plantr_controller_v1_service_proto_msgTypes[1].OneofWrappers = []any{}
@@ -514,7 +2168,7 @@ func file_plantr_controller_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plantr_controller_v1_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
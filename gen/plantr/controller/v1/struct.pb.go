@@ -9,6 +9,7 @@ package controllerv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -69,6 +70,116 @@ func (VersionType) EnumDescriptor() ([]byte, []int) {
 	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{0}
 }
 
+type SyncResult int32
+
+const (
+	SyncResult_SYNC_RESULT_UNSPECIFIED SyncResult = 0
+	SyncResult_SYNC_RESULT_SUCCESS     SyncResult = 1
+	SyncResult_SYNC_RESULT_FAILURE     SyncResult = 2
+)
+
+// Enum value maps for SyncResult.
+var (
+	SyncResult_name = map[int32]string{
+		0: "SYNC_RESULT_UNSPECIFIED",
+		1: "SYNC_RESULT_SUCCESS",
+		2: "SYNC_RESULT_FAILURE",
+	}
+	SyncResult_value = map[string]int32{
+		"SYNC_RESULT_UNSPECIFIED": 0,
+		"SYNC_RESULT_SUCCESS":     1,
+		"SYNC_RESULT_FAILURE":     2,
+	}
+)
+
+func (x SyncResult) Enum() *SyncResult {
+	p := new(SyncResult)
+	*p = x
+	return p
+}
+
+func (x SyncResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_plantr_controller_v1_struct_proto_enumTypes[1].Descriptor()
+}
+
+func (SyncResult) Type() protoreflect.EnumType {
+	return &file_plantr_controller_v1_struct_proto_enumTypes[1]
+}
+
+func (x SyncResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncResult.Descriptor instead.
+func (SyncResult) EnumDescriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{1}
+}
+
+type ConfigTrigger int32
+
+const (
+	ConfigTrigger_CONFIG_TRIGGER_UNSPECIFIED   ConfigTrigger = 0
+	ConfigTrigger_CONFIG_TRIGGER_STARTUP       ConfigTrigger = 1
+	ConfigTrigger_CONFIG_TRIGGER_WEBHOOK       ConfigTrigger = 2
+	ConfigTrigger_CONFIG_TRIGGER_FORCE_REFRESH ConfigTrigger = 3
+	ConfigTrigger_CONFIG_TRIGGER_PIN           ConfigTrigger = 4
+	ConfigTrigger_CONFIG_TRIGGER_UNPIN         ConfigTrigger = 5
+	ConfigTrigger_CONFIG_TRIGGER_POLL          ConfigTrigger = 6
+)
+
+// Enum value maps for ConfigTrigger.
+var (
+	ConfigTrigger_name = map[int32]string{
+		0: "CONFIG_TRIGGER_UNSPECIFIED",
+		1: "CONFIG_TRIGGER_STARTUP",
+		2: "CONFIG_TRIGGER_WEBHOOK",
+		3: "CONFIG_TRIGGER_FORCE_REFRESH",
+		4: "CONFIG_TRIGGER_PIN",
+		5: "CONFIG_TRIGGER_UNPIN",
+		6: "CONFIG_TRIGGER_POLL",
+	}
+	ConfigTrigger_value = map[string]int32{
+		"CONFIG_TRIGGER_UNSPECIFIED":   0,
+		"CONFIG_TRIGGER_STARTUP":       1,
+		"CONFIG_TRIGGER_WEBHOOK":       2,
+		"CONFIG_TRIGGER_FORCE_REFRESH": 3,
+		"CONFIG_TRIGGER_PIN":           4,
+		"CONFIG_TRIGGER_UNPIN":         5,
+		"CONFIG_TRIGGER_POLL":          6,
+	}
+)
+
+func (x ConfigTrigger) Enum() *ConfigTrigger {
+	p := new(ConfigTrigger)
+	*p = x
+	return p
+}
+
+func (x ConfigTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_plantr_controller_v1_struct_proto_enumTypes[2].Descriptor()
+}
+
+func (ConfigTrigger) Type() protoreflect.EnumType {
+	return &file_plantr_controller_v1_struct_proto_enumTypes[2]
+}
+
+func (x ConfigTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigTrigger.Descriptor instead.
+func (ConfigTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{2}
+}
+
 type ConfigFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -132,6 +243,69 @@ func (x *ConfigFile) GetMode() string {
 	return ""
 }
 
+type Signature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Url       string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Signature) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Signature) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *Signature) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
 type GithubRelease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -143,12 +317,14 @@ type GithubRelease struct {
 	NameOverride         *string                       `protobuf:"bytes,4,opt,name=name_override,json=nameOverride,proto3,oneof" json:"name_override,omitempty"`
 	ArchiveRelease       bool                          `protobuf:"varint,5,opt,name=archive_release,json=archiveRelease,proto3" json:"archive_release,omitempty"`
 	BinaryRegex          *string                       `protobuf:"bytes,6,opt,name=binary_regex,json=binaryRegex,proto3,oneof" json:"binary_regex,omitempty"`
+	Sha256               *string                       `protobuf:"bytes,7,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`
+	Signature            *Signature                    `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *GithubRelease) Reset() {
 	*x = GithubRelease{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[1]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -161,7 +337,7 @@ func (x *GithubRelease) String() string {
 func (*GithubRelease) ProtoMessage() {}
 
 func (x *GithubRelease) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[1]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +350,7 @@ func (x *GithubRelease) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubRelease.ProtoReflect.Descriptor instead.
 func (*GithubRelease) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{1}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GithubRelease) GetDownloadUrl() string {
@@ -219,6 +395,20 @@ func (x *GithubRelease) GetBinaryRegex() string {
 	return ""
 }
 
+func (x *GithubRelease) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+func (x *GithubRelease) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type SystemPackage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -235,7 +425,7 @@ type SystemPackage struct {
 func (x *SystemPackage) Reset() {
 	*x = SystemPackage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[2]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -248,7 +438,7 @@ func (x *SystemPackage) String() string {
 func (*SystemPackage) ProtoMessage() {}
 
 func (x *SystemPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[2]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +451,7 @@ func (x *SystemPackage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemPackage.ProtoReflect.Descriptor instead.
 func (*SystemPackage) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{2}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{3}
 }
 
 func (m *SystemPackage) GetPkg() isSystemPackage_Pkg {
@@ -331,7 +521,7 @@ type GitRepo struct {
 func (x *GitRepo) Reset() {
 	*x = GitRepo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[3]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -344,7 +534,7 @@ func (x *GitRepo) String() string {
 func (*GitRepo) ProtoMessage() {}
 
 func (x *GitRepo) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[3]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +547,7 @@ func (x *GitRepo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitRepo.ProtoReflect.Descriptor instead.
 func (*GitRepo) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{3}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GitRepo) GetUrl() string {
@@ -422,7 +612,7 @@ type Golang struct {
 func (x *Golang) Reset() {
 	*x = Golang{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[4]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -435,7 +625,7 @@ func (x *Golang) String() string {
 func (*Golang) ProtoMessage() {}
 
 func (x *Golang) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[4]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +638,7 @@ func (x *Golang) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Golang.ProtoReflect.Descriptor instead.
 func (*Golang) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{4}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Golang) GetVersion() string {
@@ -470,7 +660,7 @@ type GoInstall struct {
 func (x *GoInstall) Reset() {
 	*x = GoInstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[5]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -483,7 +673,7 @@ func (x *GoInstall) String() string {
 func (*GoInstall) ProtoMessage() {}
 
 func (x *GoInstall) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[5]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +686,7 @@ func (x *GoInstall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoInstall.ProtoReflect.Descriptor instead.
 func (*GoInstall) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{5}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GoInstall) GetPackage() string {
@@ -518,16 +708,18 @@ type UrlDownload struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DownloadUrl          string  `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	DestinationDirectory string  `protobuf:"bytes,2,opt,name=destination_directory,json=destinationDirectory,proto3" json:"destination_directory,omitempty"`
-	NameOverride         *string `protobuf:"bytes,3,opt,name=name_override,json=nameOverride,proto3,oneof" json:"name_override,omitempty"`
-	ArchiveRelease       bool    `protobuf:"varint,4,opt,name=archive_release,json=archiveRelease,proto3" json:"archive_release,omitempty"`
+	DownloadUrl          string     `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DestinationDirectory string     `protobuf:"bytes,2,opt,name=destination_directory,json=destinationDirectory,proto3" json:"destination_directory,omitempty"`
+	NameOverride         *string    `protobuf:"bytes,3,opt,name=name_override,json=nameOverride,proto3,oneof" json:"name_override,omitempty"`
+	ArchiveRelease       bool       `protobuf:"varint,4,opt,name=archive_release,json=archiveRelease,proto3" json:"archive_release,omitempty"`
+	Sha256               *string    `protobuf:"bytes,5,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`
+	Signature            *Signature `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *UrlDownload) Reset() {
 	*x = UrlDownload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[6]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -540,7 +732,7 @@ func (x *UrlDownload) String() string {
 func (*UrlDownload) ProtoMessage() {}
 
 func (x *UrlDownload) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[6]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +745,7 @@ func (x *UrlDownload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UrlDownload.ProtoReflect.Descriptor instead.
 func (*UrlDownload) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{6}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UrlDownload) GetDownloadUrl() string {
@@ -584,6 +776,20 @@ func (x *UrlDownload) GetArchiveRelease() bool {
 	return false
 }
 
+func (x *UrlDownload) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+func (x *UrlDownload) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type Seed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -605,7 +811,7 @@ type Seed struct {
 func (x *Seed) Reset() {
 	*x = Seed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[7]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -618,7 +824,7 @@ func (x *Seed) String() string {
 func (*Seed) ProtoMessage() {}
 
 func (x *Seed) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[7]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +837,7 @@ func (x *Seed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Seed.ProtoReflect.Descriptor instead.
 func (*Seed) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{7}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Seed) GetMetadata() *Seed_Metadata {
@@ -743,31 +949,33 @@ func (*Seed_GoInstall) isSeed_Element() {}
 
 func (*Seed_UrlDownload) isSeed_Element() {}
 
-type GithubRelease_Authentication struct {
+type SeedFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BearerAuth string `protobuf:"bytes,1,opt,name=bearer_auth,json=bearerAuth,proto3" json:"bearer_auth,omitempty"`
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Error       string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *GithubRelease_Authentication) Reset() {
-	*x = GithubRelease_Authentication{}
+func (x *SeedFailure) Reset() {
+	*x = SeedFailure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[8]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GithubRelease_Authentication) String() string {
+func (x *SeedFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GithubRelease_Authentication) ProtoMessage() {}
+func (*SeedFailure) ProtoMessage() {}
 
-func (x *GithubRelease_Authentication) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[8]
+func (x *SeedFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,43 +986,67 @@ func (x *GithubRelease_Authentication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GithubRelease_Authentication.ProtoReflect.Descriptor instead.
-func (*GithubRelease_Authentication) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use SeedFailure.ProtoReflect.Descriptor instead.
+func (*SeedFailure) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GithubRelease_Authentication) GetBearerAuth() string {
+func (x *SeedFailure) GetHash() string {
 	if x != nil {
-		return x.BearerAuth
+		return x.Hash
 	}
 	return ""
 }
 
-type SystemPackage_AptPkg struct {
+func (x *SeedFailure) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SeedFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type NodeStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *SystemPackage_AptPkg) Reset() {
-	*x = SystemPackage_AptPkg{}
+	NodeId   string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// LastSeen is the last time the node reported a sync, unset if it never has
+	LastSeen       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	LastSyncResult SyncResult             `protobuf:"varint,4,opt,name=last_sync_result,json=lastSyncResult,proto3,enum=plantr.controller.v1.SyncResult" json:"last_sync_result,omitempty"`
+	AppliedCommit  string                 `protobuf:"bytes,5,opt,name=applied_commit,json=appliedCommit,proto3" json:"applied_commit,omitempty"`
+	FailingSeeds   []*SeedFailure         `protobuf:"bytes,6,rep,name=failing_seeds,json=failingSeeds,proto3" json:"failing_seeds,omitempty"`
+	// Channel is the channel the node is served from, empty for the default channel
+	Channel string `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// ChannelCommit is the config repo commit the node's channel is currently serving
+	ChannelCommit string `protobuf:"bytes,8,opt,name=channel_commit,json=channelCommit,proto3" json:"channel_commit,omitempty"`
+}
+
+func (x *NodeStatus) Reset() {
+	*x = NodeStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[9]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemPackage_AptPkg) String() string {
+func (x *NodeStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemPackage_AptPkg) ProtoMessage() {}
+func (*NodeStatus) ProtoMessage() {}
 
-func (x *SystemPackage_AptPkg) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[9]
+func (x *NodeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,75 +1057,80 @@ func (x *SystemPackage_AptPkg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemPackage_AptPkg.ProtoReflect.Descriptor instead.
-func (*SystemPackage_AptPkg) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use NodeStatus.ProtoReflect.Descriptor instead.
+func (*NodeStatus) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SystemPackage_AptPkg) GetName() string {
+func (x *NodeStatus) GetNodeId() string {
 	if x != nil {
-		return x.Name
+		return x.NodeId
 	}
 	return ""
 }
 
-type SystemPackage_BrewPkg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (x *NodeStatus) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
 }
 
-func (x *SystemPackage_BrewPkg) Reset() {
-	*x = SystemPackage_BrewPkg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *NodeStatus) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
 	}
+	return nil
 }
 
-func (x *SystemPackage_BrewPkg) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeStatus) GetLastSyncResult() SyncResult {
+	if x != nil {
+		return x.LastSyncResult
+	}
+	return SyncResult_SYNC_RESULT_UNSPECIFIED
 }
 
-func (*SystemPackage_BrewPkg) ProtoMessage() {}
+func (x *NodeStatus) GetAppliedCommit() string {
+	if x != nil {
+		return x.AppliedCommit
+	}
+	return ""
+}
 
-func (x *SystemPackage_BrewPkg) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *NodeStatus) GetFailingSeeds() []*SeedFailure {
+	if x != nil {
+		return x.FailingSeeds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SystemPackage_BrewPkg.ProtoReflect.Descriptor instead.
-func (*SystemPackage_BrewPkg) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{2, 1}
+func (x *NodeStatus) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
 }
 
-func (x *SystemPackage_BrewPkg) GetName() string {
+func (x *NodeStatus) GetChannelCommit() string {
 	if x != nil {
-		return x.Name
+		return x.ChannelCommit
 	}
 	return ""
 }
 
-type SystemPackage_PacmanPkg struct {
+type PushSyncResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeId string     `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Result SyncResult `protobuf:"varint,2,opt,name=result,proto3,enum=plantr.controller.v1.SyncResult" json:"result,omitempty"`
+	// Error is the reason the agent could not be synced, empty on success
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *SystemPackage_PacmanPkg) Reset() {
-	*x = SystemPackage_PacmanPkg{}
+func (x *PushSyncResult) Reset() {
+	*x = PushSyncResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_plantr_controller_v1_struct_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -901,13 +1138,13 @@ func (x *SystemPackage_PacmanPkg) Reset() {
 	}
 }
 
-func (x *SystemPackage_PacmanPkg) String() string {
+func (x *PushSyncResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemPackage_PacmanPkg) ProtoMessage() {}
+func (*PushSyncResult) ProtoMessage() {}
 
-func (x *SystemPackage_PacmanPkg) ProtoReflect() protoreflect.Message {
+func (x *PushSyncResult) ProtoReflect() protoreflect.Message {
 	mi := &file_plantr_controller_v1_struct_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -919,9 +1156,542 @@ func (x *SystemPackage_PacmanPkg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemPackage_PacmanPkg.ProtoReflect.Descriptor instead.
+// Deprecated: Use PushSyncResult.ProtoReflect.Descriptor instead.
+func (*PushSyncResult) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PushSyncResult) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *PushSyncResult) GetResult() SyncResult {
+	if x != nil {
+		return x.Result
+	}
+	return SyncResult_SYNC_RESULT_UNSPECIFIED
+}
+
+func (x *PushSyncResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type OutdatedSeed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kind is the type of seed, i.e github_release
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Name identifies what is pinned, i.e the repo or package
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Current is the pinned version
+	Current string `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	// Latest is the latest upstream version, empty if it couldn't be determined
+	Latest string `protobuf:"bytes,4,opt,name=latest,proto3" json:"latest,omitempty"`
+	// Roles are the roles that use the seed
+	Roles []string `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Outdated is set when latest is newer than current
+	Outdated bool `protobuf:"varint,6,opt,name=outdated,proto3" json:"outdated,omitempty"`
+	// Error is set when the latest version couldn't be determined
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *OutdatedSeed) Reset() {
+	*x = OutdatedSeed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutdatedSeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutdatedSeed) ProtoMessage() {}
+
+func (x *OutdatedSeed) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutdatedSeed.ProtoReflect.Descriptor instead.
+func (*OutdatedSeed) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OutdatedSeed) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OutdatedSeed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OutdatedSeed) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *OutdatedSeed) GetLatest() string {
+	if x != nil {
+		return x.Latest
+	}
+	return ""
+}
+
+func (x *OutdatedSeed) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *OutdatedSeed) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
+	}
+	return false
+}
+
+func (x *OutdatedSeed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GithubRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Host is the API host the quota applies to
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Resource is the rate limit bucket GitHub reported, i.e core
+	Resource  string `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Limit     int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Remaining int64  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// ResetsAt is when the quota refills
+	ResetsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`
+	// UpdatedAt is when GitHub last reported the quota
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *GithubRateLimit) Reset() {
+	*x = GithubRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GithubRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GithubRateLimit) ProtoMessage() {}
+
+func (x *GithubRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GithubRateLimit.ProtoReflect.Descriptor instead.
+func (*GithubRateLimit) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GithubRateLimit) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GithubRateLimit) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *GithubRateLimit) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GithubRateLimit) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *GithubRateLimit) GetResetsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return nil
+}
+
+func (x *GithubRateLimit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ConfigRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit   string                 `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	LoadedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=loaded_at,json=loadedAt,proto3" json:"loaded_at,omitempty"`
+	// Trigger is what caused the commit to be loaded
+	Trigger ConfigTrigger `protobuf:"varint,3,opt,name=trigger,proto3,enum=plantr.controller.v1.ConfigTrigger" json:"trigger,omitempty"`
+}
+
+func (x *ConfigRevision) Reset() {
+	*x = ConfigRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRevision) ProtoMessage() {}
+
+func (x *ConfigRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRevision.ProtoReflect.Descriptor instead.
+func (*ConfigRevision) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConfigRevision) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ConfigRevision) GetLoadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedAt
+	}
+	return nil
+}
+
+func (x *ConfigRevision) GetTrigger() ConfigTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return ConfigTrigger_CONFIG_TRIGGER_UNSPECIFIED
+}
+
+type ConfigPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolledAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=polled_at,json=polledAt,proto3" json:"polled_at,omitempty"`
+	// Commit is the commit the default channel was serving after the poll
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Changed is set if the poll loaded a new commit
+	Changed bool `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
+	// Error is why the poll failed, empty if it didn't
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ConfigPoll) Reset() {
+	*x = ConfigPoll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigPoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigPoll) ProtoMessage() {}
+
+func (x *ConfigPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigPoll.ProtoReflect.Descriptor instead.
+func (*ConfigPoll) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConfigPoll) GetPolledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PolledAt
+	}
+	return nil
+}
+
+func (x *ConfigPoll) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ConfigPoll) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *ConfigPoll) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GithubRelease_Authentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BearerAuth string `protobuf:"bytes,1,opt,name=bearer_auth,json=bearerAuth,proto3" json:"bearer_auth,omitempty"`
+}
+
+func (x *GithubRelease_Authentication) Reset() {
+	*x = GithubRelease_Authentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GithubRelease_Authentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GithubRelease_Authentication) ProtoMessage() {}
+
+func (x *GithubRelease_Authentication) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GithubRelease_Authentication.ProtoReflect.Descriptor instead.
+func (*GithubRelease_Authentication) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *GithubRelease_Authentication) GetBearerAuth() string {
+	if x != nil {
+		return x.BearerAuth
+	}
+	return ""
+}
+
+type SystemPackage_AptPkg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SystemPackage_AptPkg) Reset() {
+	*x = SystemPackage_AptPkg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPackage_AptPkg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPackage_AptPkg) ProtoMessage() {}
+
+func (x *SystemPackage_AptPkg) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPackage_AptPkg.ProtoReflect.Descriptor instead.
+func (*SystemPackage_AptPkg) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *SystemPackage_AptPkg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SystemPackage_BrewPkg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SystemPackage_BrewPkg) Reset() {
+	*x = SystemPackage_BrewPkg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPackage_BrewPkg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPackage_BrewPkg) ProtoMessage() {}
+
+func (x *SystemPackage_BrewPkg) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPackage_BrewPkg.ProtoReflect.Descriptor instead.
+func (*SystemPackage_BrewPkg) Descriptor() ([]byte, []int) {
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *SystemPackage_BrewPkg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SystemPackage_PacmanPkg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SystemPackage_PacmanPkg) Reset() {
+	*x = SystemPackage_PacmanPkg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPackage_PacmanPkg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPackage_PacmanPkg) ProtoMessage() {}
+
+func (x *SystemPackage_PacmanPkg) ProtoReflect() protoreflect.Message {
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPackage_PacmanPkg.ProtoReflect.Descriptor instead.
 func (*SystemPackage_PacmanPkg) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{2, 2}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{3, 2}
 }
 
 func (x *SystemPackage_PacmanPkg) GetName() string {
@@ -943,7 +1713,7 @@ type Seed_Metadata struct {
 func (x *Seed_Metadata) Reset() {
 	*x = Seed_Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plantr_controller_v1_struct_proto_msgTypes[12]
+		mi := &file_plantr_controller_v1_struct_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -956,7 +1726,7 @@ func (x *Seed_Metadata) String() string {
 func (*Seed_Metadata) ProtoMessage() {}
 
 func (x *Seed_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_plantr_controller_v1_struct_proto_msgTypes[12]
+	mi := &file_plantr_controller_v1_struct_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1739,7 @@ func (x *Seed_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Seed_Metadata.ProtoReflect.Descriptor instead.
 func (*Seed_Metadata) Descriptor() ([]byte, []int) {
-	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{7, 0}
+	return file_plantr_controller_v1_struct_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *Seed_Metadata) GetHash() string {
@@ -992,146 +1762,267 @@ var file_plantr_controller_v1_struct_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
 	0x6c, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x5c, 0x0a, 0x0a, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x94, 0x03, 0x0a, 0x0d, 0x47, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x5a, 0x0a, 0x0e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x0a,
-	0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x12, 0x26, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x1a, 0x31, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65,
-	0x61, 0x72, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0xc0,
-	0x02, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x12, 0x3e, 0x0a, 0x03, 0x61, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5c, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x50, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xfb, 0x03, 0x0a, 0x0d, 0x47,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12,
+	0x5a, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x28, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x62, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x31, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0xc0, 0x02, 0x0a, 0x0d, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x03, 0x61, 0x70,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x70, 0x74,
+	0x50, 0x6b, 0x67, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x74, 0x12, 0x41, 0x0a, 0x04, 0x62, 0x72,
+	0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x72,
+	0x65, 0x77, 0x50, 0x6b, 0x67, 0x48, 0x00, 0x52, 0x04, 0x62, 0x72, 0x65, 0x77, 0x12, 0x47, 0x0a,
+	0x06, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
 	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
 	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x2e, 0x41, 0x70, 0x74, 0x50, 0x6b, 0x67, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x74,
-	0x12, 0x41, 0x0a, 0x04, 0x62, 0x72, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x67, 0x65, 0x2e, 0x50, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x50, 0x6b, 0x67, 0x48, 0x00, 0x52, 0x06,
+	0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x1a, 0x1c, 0x0a, 0x06, 0x41, 0x70, 0x74, 0x50, 0x6b, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1d, 0x0a, 0x07, 0x42, 0x72, 0x65, 0x77, 0x50, 0x6b, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x1a, 0x1f, 0x0a, 0x09, 0x50, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x50, 0x6b, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x22, 0x6c, 0x0a, 0x07, 0x47,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x22, 0x22, 0x0a, 0x06, 0x47, 0x6f, 0x6c,
+	0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a,
+	0x09, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xb1, 0x02, 0x0a, 0x0b, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x72, 0x6c, 0x12, 0x33, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x22, 0xf4, 0x04, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
 	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x2e, 0x42, 0x72, 0x65, 0x77, 0x50, 0x6b, 0x67, 0x48, 0x00, 0x52, 0x04, 0x62,
-	0x72, 0x65, 0x77, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x50,
-	0x6b, 0x67, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x1a, 0x1c, 0x0a, 0x06,
-	0x41, 0x70, 0x74, 0x50, 0x6b, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1d, 0x0a, 0x07, 0x42, 0x72,
-	0x65, 0x77, 0x50, 0x6b, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1f, 0x0a, 0x09, 0x50, 0x61, 0x63,
-	0x6d, 0x61, 0x6e, 0x50, 0x6b, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x70, 0x6b,
-	0x67, 0x22, 0x6c, 0x0a, 0x07, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03, 0x74, 0x61,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18,
-	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x22,
-	0x22, 0x0a, 0x06, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x09, 0x47, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xca, 0x01, 0x0a, 0x0b, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x0a,
-	0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-	0x64, 0x65, 0x22, 0xf4, 0x04, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x0d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3a,
+	0x0a, 0x08, 0x67, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x48,
+	0x00, 0x52, 0x07, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x36, 0x0a, 0x06, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x06, 0x67, 0x6f, 0x6c, 0x61,
+	0x6e, 0x67, 0x12, 0x40, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x09, 0x67, 0x6f, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x75, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x41, 0x0a, 0x08,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x0b, 0x53, 0x65,
+	0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf6, 0x02, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x12, 0x4a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
 	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
-	0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x0d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
-	0x4c, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a,
-	0x08, 0x67, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00,
-	0x52, 0x07, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x36, 0x0a, 0x06, 0x67, 0x6f, 0x6c,
-	0x61, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x06, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
-	0x67, 0x12, 0x40, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x09, 0x67, 0x6f, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0b,
-	0x75, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x41, 0x0a, 0x08, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x09,
-	0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2a, 0x5d, 0x0a, 0x0b, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x45, 0x52, 0x53,
-	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x49, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x02, 0x42, 0xe0, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x65, 0x64, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22,
+	0x79, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x4f,
+	0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
+	0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe9, 0x01,
+	0x0a, 0x0f, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a,
+	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
 	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6e, 0x69, 0x63, 0x6a, 0x6f, 0x68, 0x6e, 0x73, 0x6f, 0x6e, 0x31, 0x34, 0x35, 0x2f, 0x70,
-	0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72,
-	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x43,
-	0x58, 0xaa, 0x02, 0x14, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x50, 0x6c, 0x61, 0x6e, 0x74,
-	0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x20, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x16, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0x8d, 0x01, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x37, 0x0a, 0x09, 0x70,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x5d, 0x0a, 0x0b,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x49, 0x4e, 0x4e, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x0a, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52,
+	0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0xd4, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x55, 0x50, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b,
+	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x49,
+	0x47, 0x47, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45,
+	0x53, 0x48, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54,
+	0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x50, 0x49, 0x4e, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x06, 0x42,
+	0xe0, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x63, 0x6a, 0x6f, 0x68, 0x6e, 0x73,
+	0x6f, 0x6e, 0x31, 0x34, 0x35, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x43, 0x58, 0xaa, 0x02, 0x14, 0x50, 0x6c, 0x61, 0x6e, 0x74,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x14, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x72, 0x5c,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x50, 0x6c, 0x61, 0x6e,
+	0x74, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1146,42 +2037,64 @@ func file_plantr_controller_v1_struct_proto_rawDescGZIP() []byte {
 	return file_plantr_controller_v1_struct_proto_rawDescData
 }
 
-var file_plantr_controller_v1_struct_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_plantr_controller_v1_struct_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_plantr_controller_v1_struct_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_plantr_controller_v1_struct_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_plantr_controller_v1_struct_proto_goTypes = []any{
 	(VersionType)(0),                     // 0: plantr.controller.v1.VersionType
-	(*ConfigFile)(nil),                   // 1: plantr.controller.v1.ConfigFile
-	(*GithubRelease)(nil),                // 2: plantr.controller.v1.GithubRelease
-	(*SystemPackage)(nil),                // 3: plantr.controller.v1.SystemPackage
-	(*GitRepo)(nil),                      // 4: plantr.controller.v1.GitRepo
-	(*Golang)(nil),                       // 5: plantr.controller.v1.Golang
-	(*GoInstall)(nil),                    // 6: plantr.controller.v1.GoInstall
-	(*UrlDownload)(nil),                  // 7: plantr.controller.v1.UrlDownload
-	(*Seed)(nil),                         // 8: plantr.controller.v1.Seed
-	(*GithubRelease_Authentication)(nil), // 9: plantr.controller.v1.GithubRelease.Authentication
-	(*SystemPackage_AptPkg)(nil),         // 10: plantr.controller.v1.SystemPackage.AptPkg
-	(*SystemPackage_BrewPkg)(nil),        // 11: plantr.controller.v1.SystemPackage.BrewPkg
-	(*SystemPackage_PacmanPkg)(nil),      // 12: plantr.controller.v1.SystemPackage.PacmanPkg
-	(*Seed_Metadata)(nil),                // 13: plantr.controller.v1.Seed.Metadata
+	(SyncResult)(0),                      // 1: plantr.controller.v1.SyncResult
+	(ConfigTrigger)(0),                   // 2: plantr.controller.v1.ConfigTrigger
+	(*ConfigFile)(nil),                   // 3: plantr.controller.v1.ConfigFile
+	(*Signature)(nil),                    // 4: plantr.controller.v1.Signature
+	(*GithubRelease)(nil),                // 5: plantr.controller.v1.GithubRelease
+	(*SystemPackage)(nil),                // 6: plantr.controller.v1.SystemPackage
+	(*GitRepo)(nil),                      // 7: plantr.controller.v1.GitRepo
+	(*Golang)(nil),                       // 8: plantr.controller.v1.Golang
+	(*GoInstall)(nil),                    // 9: plantr.controller.v1.GoInstall
+	(*UrlDownload)(nil),                  // 10: plantr.controller.v1.UrlDownload
+	(*Seed)(nil),                         // 11: plantr.controller.v1.Seed
+	(*SeedFailure)(nil),                  // 12: plantr.controller.v1.SeedFailure
+	(*NodeStatus)(nil),                   // 13: plantr.controller.v1.NodeStatus
+	(*PushSyncResult)(nil),               // 14: plantr.controller.v1.PushSyncResult
+	(*OutdatedSeed)(nil),                 // 15: plantr.controller.v1.OutdatedSeed
+	(*GithubRateLimit)(nil),              // 16: plantr.controller.v1.GithubRateLimit
+	(*ConfigRevision)(nil),               // 17: plantr.controller.v1.ConfigRevision
+	(*ConfigPoll)(nil),                   // 18: plantr.controller.v1.ConfigPoll
+	(*GithubRelease_Authentication)(nil), // 19: plantr.controller.v1.GithubRelease.Authentication
+	(*SystemPackage_AptPkg)(nil),         // 20: plantr.controller.v1.SystemPackage.AptPkg
+	(*SystemPackage_BrewPkg)(nil),        // 21: plantr.controller.v1.SystemPackage.BrewPkg
+	(*SystemPackage_PacmanPkg)(nil),      // 22: plantr.controller.v1.SystemPackage.PacmanPkg
+	(*Seed_Metadata)(nil),                // 23: plantr.controller.v1.Seed.Metadata
+	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
 }
 var file_plantr_controller_v1_struct_proto_depIdxs = []int32{
-	9,  // 0: plantr.controller.v1.GithubRelease.authentication:type_name -> plantr.controller.v1.GithubRelease.Authentication
-	10, // 1: plantr.controller.v1.SystemPackage.apt:type_name -> plantr.controller.v1.SystemPackage.AptPkg
-	11, // 2: plantr.controller.v1.SystemPackage.brew:type_name -> plantr.controller.v1.SystemPackage.BrewPkg
-	12, // 3: plantr.controller.v1.SystemPackage.pacman:type_name -> plantr.controller.v1.SystemPackage.PacmanPkg
-	13, // 4: plantr.controller.v1.Seed.metadata:type_name -> plantr.controller.v1.Seed.Metadata
-	1,  // 5: plantr.controller.v1.Seed.config_file:type_name -> plantr.controller.v1.ConfigFile
-	2,  // 6: plantr.controller.v1.Seed.github_release:type_name -> plantr.controller.v1.GithubRelease
-	3,  // 7: plantr.controller.v1.Seed.system_package:type_name -> plantr.controller.v1.SystemPackage
-	4,  // 8: plantr.controller.v1.Seed.git_repo:type_name -> plantr.controller.v1.GitRepo
-	5,  // 9: plantr.controller.v1.Seed.golang:type_name -> plantr.controller.v1.Golang
-	6,  // 10: plantr.controller.v1.Seed.go_install:type_name -> plantr.controller.v1.GoInstall
-	7,  // 11: plantr.controller.v1.Seed.url_download:type_name -> plantr.controller.v1.UrlDownload
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	19, // 0: plantr.controller.v1.GithubRelease.authentication:type_name -> plantr.controller.v1.GithubRelease.Authentication
+	4,  // 1: plantr.controller.v1.GithubRelease.signature:type_name -> plantr.controller.v1.Signature
+	20, // 2: plantr.controller.v1.SystemPackage.apt:type_name -> plantr.controller.v1.SystemPackage.AptPkg
+	21, // 3: plantr.controller.v1.SystemPackage.brew:type_name -> plantr.controller.v1.SystemPackage.BrewPkg
+	22, // 4: plantr.controller.v1.SystemPackage.pacman:type_name -> plantr.controller.v1.SystemPackage.PacmanPkg
+	4,  // 5: plantr.controller.v1.UrlDownload.signature:type_name -> plantr.controller.v1.Signature
+	23, // 6: plantr.controller.v1.Seed.metadata:type_name -> plantr.controller.v1.Seed.Metadata
+	3,  // 7: plantr.controller.v1.Seed.config_file:type_name -> plantr.controller.v1.ConfigFile
+	5,  // 8: plantr.controller.v1.Seed.github_release:type_name -> plantr.controller.v1.GithubRelease
+	6,  // 9: plantr.controller.v1.Seed.system_package:type_name -> plantr.controller.v1.SystemPackage
+	7,  // 10: plantr.controller.v1.Seed.git_repo:type_name -> plantr.controller.v1.GitRepo
+	8,  // 11: plantr.controller.v1.Seed.golang:type_name -> plantr.controller.v1.Golang
+	9,  // 12: plantr.controller.v1.Seed.go_install:type_name -> plantr.controller.v1.GoInstall
+	10, // 13: plantr.controller.v1.Seed.url_download:type_name -> plantr.controller.v1.UrlDownload
+	24, // 14: plantr.controller.v1.NodeStatus.last_seen:type_name -> google.protobuf.Timestamp
+	1,  // 15: plantr.controller.v1.NodeStatus.last_sync_result:type_name -> plantr.controller.v1.SyncResult
+	12, // 16: plantr.controller.v1.NodeStatus.failing_seeds:type_name -> plantr.controller.v1.SeedFailure
+	1,  // 17: plantr.controller.v1.PushSyncResult.result:type_name -> plantr.controller.v1.SyncResult
+	24, // 18: plantr.controller.v1.GithubRateLimit.resets_at:type_name -> google.protobuf.Timestamp
+	24, // 19: plantr.controller.v1.GithubRateLimit.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 20: plantr.controller.v1.ConfigRevision.loaded_at:type_name -> google.protobuf.Timestamp
+	2,  // 21: plantr.controller.v1.ConfigRevision.trigger:type_name -> plantr.controller.v1.ConfigTrigger
+	24, // 22: plantr.controller.v1.ConfigPoll.polled_at:type_name -> google.protobuf.Timestamp
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_plantr_controller_v1_struct_proto_init() }
@@ -1203,7 +2116,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GithubRelease); i {
+			switch v := v.(*Signature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1215,7 +2128,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage); i {
+			switch v := v.(*GithubRelease); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1227,7 +2140,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GitRepo); i {
+			switch v := v.(*SystemPackage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1239,7 +2152,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Golang); i {
+			switch v := v.(*GitRepo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1251,7 +2164,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GoInstall); i {
+			switch v := v.(*Golang); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1263,7 +2176,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*UrlDownload); i {
+			switch v := v.(*GoInstall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1275,7 +2188,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Seed); i {
+			switch v := v.(*UrlDownload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1287,7 +2200,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GithubRelease_Authentication); i {
+			switch v := v.(*Seed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1299,7 +2212,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage_AptPkg); i {
+			switch v := v.(*SeedFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1311,7 +2224,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage_BrewPkg); i {
+			switch v := v.(*NodeStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1323,7 +2236,7 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*SystemPackage_PacmanPkg); i {
+			switch v := v.(*PushSyncResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,6 +2248,102 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 		file_plantr_controller_v1_struct_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*OutdatedSeed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GithubRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GithubRelease_Authentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*SystemPackage_AptPkg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*SystemPackage_BrewPkg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*SystemPackage_PacmanPkg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plantr_controller_v1_struct_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*Seed_Metadata); i {
 			case 0:
 				return &v.state
@@ -1347,19 +2356,19 @@ func file_plantr_controller_v1_struct_proto_init() {
 			}
 		}
 	}
-	file_plantr_controller_v1_struct_proto_msgTypes[1].OneofWrappers = []any{}
-	file_plantr_controller_v1_struct_proto_msgTypes[2].OneofWrappers = []any{
+	file_plantr_controller_v1_struct_proto_msgTypes[2].OneofWrappers = []any{}
+	file_plantr_controller_v1_struct_proto_msgTypes[3].OneofWrappers = []any{
 		(*SystemPackage_Apt)(nil),
 		(*SystemPackage_Brew)(nil),
 		(*SystemPackage_Pacman)(nil),
 	}
-	file_plantr_controller_v1_struct_proto_msgTypes[3].OneofWrappers = []any{
+	file_plantr_controller_v1_struct_proto_msgTypes[4].OneofWrappers = []any{
 		(*GitRepo_Tag)(nil),
 		(*GitRepo_Commit)(nil),
 	}
-	file_plantr_controller_v1_struct_proto_msgTypes[5].OneofWrappers = []any{}
 	file_plantr_controller_v1_struct_proto_msgTypes[6].OneofWrappers = []any{}
-	file_plantr_controller_v1_struct_proto_msgTypes[7].OneofWrappers = []any{
+	file_plantr_controller_v1_struct_proto_msgTypes[7].OneofWrappers = []any{}
+	file_plantr_controller_v1_struct_proto_msgTypes[8].OneofWrappers = []any{
 		(*Seed_ConfigFile)(nil),
 		(*Seed_GithubRelease)(nil),
 		(*Seed_SystemPackage)(nil),
@@ -1373,8 +2382,8 @@ func file_plantr_controller_v1_struct_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plantr_controller_v1_struct_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
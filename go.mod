@@ -7,6 +7,8 @@ require (
 	buf.build/go/protoyaml v0.2.0
 	connectrpc.com/connect v1.17.0
 	connectrpc.com/grpcreflect v1.2.0
+	github.com/Masterminds/semver/v3 v3.3.1
+	github.com/ProtonMail/go-crypto v1.0.0
 	github.com/bufbuild/protovalidate-go v0.7.3
 	github.com/carlmjohnson/requests v0.24.2
 	github.com/go-git/go-billy/v5 v5.6.0
@@ -18,6 +20,7 @@ require (
 	github.com/jarcoal/httpmock v1.3.1
 	github.com/jmoiron/sqlx v1.4.0
 	github.com/lithammer/dedent v1.1.0
+	github.com/mattn/go-isatty v0.0.20
 	github.com/mholt/archives v0.1.0
 	github.com/nicjohnson145/hlp v0.9.0
 	github.com/oklog/ulid/v2 v2.1.0
@@ -27,7 +30,10 @@ require (
 	github.com/spf13/cobra v1.8.1
 	github.com/spf13/viper v1.19.0
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/crypto v0.28.0
+	golang.org/x/mod v0.21.0
 	golang.org/x/net v0.30.0
+	golang.org/x/sync v0.9.0
 	google.golang.org/protobuf v1.35.2
 	modernc.org/sqlite v1.18.1
 )
@@ -36,7 +42,6 @@ require (
 	cel.dev/expr v0.18.0 // indirect
 	dario.cat/mergo v1.0.0 // indirect
 	github.com/Microsoft/go-winio v0.6.2 // indirect
-	github.com/ProtonMail/go-crypto v1.0.0 // indirect
 	github.com/STARRY-S/zip v0.2.1 // indirect
 	github.com/andybalholm/brotli v1.1.1 // indirect
 	github.com/antlr4-go/antlr/v4 v4.13.0 // indirect
@@ -70,7 +75,6 @@ require (
 	github.com/klauspost/pgzip v1.2.6 // indirect
 	github.com/magiconair/properties v1.8.7 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
-	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mitchellh/go-homedir v1.1.0 // indirect
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/nwaples/rardecode/v2 v2.0.0-beta.4.0.20241112120701-034e449c6e78 // indirect
@@ -98,10 +102,7 @@ require (
 	go.uber.org/atomic v1.9.0 // indirect
 	go.uber.org/multierr v1.9.0 // indirect
 	go4.org v0.0.0-20230225012048-214862532bf5 // indirect
-	golang.org/x/crypto v0.28.0 // indirect
 	golang.org/x/exp v0.0.0-20240719175910-8a7402abbf56 // indirect
-	golang.org/x/mod v0.21.0 // indirect
-	golang.org/x/sync v0.9.0 // indirect
 	golang.org/x/sys v0.26.0 // indirect
 	golang.org/x/text v0.20.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/nicjohnson145/plantr/internal/encryption"
+	"github.com/nicjohnson145/plantr/internal/interceptors"
 	"github.com/nicjohnson145/plantr/internal/logging"
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
@@ -45,5 +47,57 @@ func NewAgentFromEnv(logger zerolog.Logger) (*Agent, func(), error) {
 		ControllerAddress: controllerAddress,
 		PrivateKey:        string(privateKeyBytes),
 		Inventory:         inventory,
+		SeedConcurrency:   viper.GetInt(SeedConcurrency),
+		DownloadCache:     NewDownloadCacheFromEnv(logging.Component(logger, "download-cache")),
+		DownloadRetry: DownloadRetryConfig{
+			MaxAttempts:    viper.GetInt(DownloadMaxAttempts),
+			InitialBackoff: viper.GetDuration(DownloadInitialBackoff),
+			MaxBackoff:     viper.GetDuration(DownloadMaxBackoff),
+			AttemptTimeout: viper.GetDuration(DownloadAttemptTimeout),
+		},
 	}), cleanup, nil
 }
+
+// NewDownloadCacheFromEnv returns the configured download cache, or nil if caching is disabled
+func NewDownloadCacheFromEnv(logger zerolog.Logger) *DownloadCache {
+	if !viper.GetBool(DownloadCacheEnabled) {
+		return nil
+	}
+
+	return NewDownloadCache(DownloadCacheConfig{
+		Logger:    logger,
+		Directory: viper.GetString(DownloadCacheDirectory),
+		MaxBytes:  viper.GetInt64(DownloadCacheMaxSizeMB) * 1024 * 1024,
+	})
+}
+
+// NewSyncAuthFromEnv builds the auth config used to verify that calls to the agent were issued by the controller
+func NewSyncAuthFromEnv() (interceptors.AuthInterceptorConfig, error) {
+	conf := interceptors.AuthInterceptorConfig{
+		Audience:      viper.GetString(NodeID),
+		Authorization: interceptors.AgentAuthorizationTable,
+	}
+	if conf.Audience == "" {
+		return conf, errors.New("node id must be set")
+	}
+
+	if keyPath := viper.GetString(AgentAuthPublicKeyPath); keyPath != "" {
+		keyBytes, err := os.ReadFile(keyPath)
+		if err != nil {
+			return conf, fmt.Errorf("error reading controller public key: %w", err)
+		}
+		conf.PublicKey, err = encryption.DecodePublicKey(string(keyBytes))
+		if err != nil {
+			return conf, fmt.Errorf("error decoding controller public key: %w", err)
+		}
+		return conf, nil
+	}
+
+	secret := viper.GetString(AgentAuthSecret)
+	if secret == "" {
+		return conf, errors.New("controller public key path or shared secret must be set")
+	}
+	conf.SigningKey = []byte(secret)
+
+	return conf, nil
+}
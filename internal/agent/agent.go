@@ -9,7 +9,9 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,6 +30,26 @@ var (
 	ErrSyncInProgressError = errors.New("sync already in progress")
 )
 
+const (
+	// how long to wait on the controller to accept a sync report before giving up on it
+	reportSyncTimeout = 30 * time.Second
+)
+
+// SeedError is an error encountered while executing a specific seed
+type SeedError struct {
+	Seed    *controllerv1.Seed
+	Context string
+	Err     error
+}
+
+func (s *SeedError) Error() string {
+	return fmt.Sprintf("%v: %v, %v", s.Seed.Metadata.DisplayName, s.Context, s.Err)
+}
+
+func (s *SeedError) Unwrap() error {
+	return s.Err
+}
+
 var (
 	// escape hatch for unit tests to handle the "update" portion of system update
 	unitTestSystemUpdateFunc func() error
@@ -41,6 +63,9 @@ type AgentConfig struct {
 	NowFunc           func() time.Time
 	HTTPClient        *http.Client
 	Inventory         InventoryClient
+	SeedConcurrency   int
+	DownloadCache     *DownloadCache
+	DownloadRetry     DownloadRetryConfig
 }
 
 func NewAgent(conf AgentConfig) *Agent {
@@ -53,6 +78,14 @@ func NewAgent(conf AgentConfig) *Agent {
 		nowFunc:           conf.NowFunc,
 		httpClient:        conf.HTTPClient,
 		inventory:         conf.Inventory,
+		inventoryMu:       &sync.Mutex{},
+		seedConcurrency:   conf.SeedConcurrency,
+		downloadCache:     conf.DownloadCache,
+		downloadRetry:     conf.DownloadRetry,
+	}
+
+	if a.seedConcurrency <= 0 {
+		a.seedConcurrency = 1
 	}
 
 	if a.nowFunc == nil {
@@ -76,6 +109,10 @@ type Agent struct {
 	nowFunc         func() time.Time
 	httpClient      *http.Client
 	inventory       InventoryClient
+	inventoryMu     *sync.Mutex
+	seedConcurrency int
+	downloadCache   *DownloadCache
+	downloadRetry   DownloadRetryConfig
 }
 
 func (a *Agent) logAndHandleError(err error, msg string) error {
@@ -109,42 +146,123 @@ func (a *Agent) newClientWithToken() (controllerv1connect.ControllerServiceClien
 }
 
 func (a *Agent) Sync(ctx context.Context, req *pbv1.SyncRequest) (*pbv1.SyncResponse, error) {
+	return a.SyncWithEvents(ctx, req, nil)
+}
+
+// SyncEventFunc receives progress events as a sync runs
+type SyncEventFunc func(*pbv1.SyncEvent)
+
+// SyncWithEvents performs a sync, calling events (if non-nil) as each seed is processed
+func (a *Agent) SyncWithEvents(ctx context.Context, req *pbv1.SyncRequest, events SyncEventFunc) (*pbv1.SyncResponse, error) {
 	if !a.mu.TryLock() {
 		return nil, ErrSyncInProgressError
 	}
 	defer a.mu.Unlock()
 
+	emit := func(event *pbv1.SyncEvent) {
+		if events != nil {
+			events(event)
+		}
+	}
+
+	err := a.sync(ctx, emit)
+
+	finished := &pbv1.SyncEvent{
+		Kind:    pbv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_FINISHED,
+		Message: "sync completed successfully",
+	}
+	if err != nil {
+		finished.Message = "sync failed"
+		finished.Error = err.Error()
+	}
+	emit(finished)
+
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (a *Agent) sync(ctx context.Context, emit SyncEventFunc) error {
 	a.log.Info().Msg("beginning sync")
 
 	a.log.Debug().Msg("fetching sync data")
 	client, err := a.newClientWithToken()
 	if err != nil {
-		return nil, fmt.Errorf("error constructing client: %w", err)
+		return fmt.Errorf("error constructing client: %w", err)
+	}
+	hashes, err := a.inventory.ListHashes(ctx)
+	if err != nil {
+		return a.logAndHandleError(err, "error listing inventory")
 	}
-	resp, err := client.GetSyncData(context.Background(), connect.NewRequest(&controllerv1.GetSyncDataRequest{}))
+	resp, err := client.GetSyncData(context.Background(), connect.NewRequest(&controllerv1.GetSyncDataRequest{
+		InventoryHashes: hashes,
+	}))
 	if err != nil {
-		return nil, a.logAndHandleError(err, "error getting sync data")
+		return a.logAndHandleError(err, "error getting sync data")
 	}
 
-	if err := a.executeSeeds(ctx, resp.Msg.Seeds); err != nil {
-		return nil, a.logAndHandleError(err, "error executing seeds")
+	if len(resp.Msg.RemovedHashes) > 0 {
+		a.log.Debug().Msgf("removing %v stale inventory rows", len(resp.Msg.RemovedHashes))
+		if err := a.inventory.DeleteRows(ctx, resp.Msg.RemovedHashes); err != nil {
+			return a.logAndHandleError(err, "error removing stale inventory")
+		}
+	}
+
+	execErr := a.executeSeeds(ctx, resp.Msg.Seeds, emit)
+	a.reportSync(client, resp.Msg.Commit, execErr)
+	if execErr != nil {
+		return a.logAndHandleError(execErr, "error executing seeds")
 	}
 
 	a.log.Info().Msg("sync completed successfully")
-	return nil, nil
+	return nil
 }
 
-func (a *Agent) ForceRefresh(ctx context.Context) error {
-	client, err := a.newClientWithToken()
-	if err != nil {
-		return fmt.Errorf("error constructing client: %w", err)
+// reportSync lets the controller know the outcome of a sync. Failing to report is logged but does not fail the sync
+func (a *Agent) reportSync(client controllerv1connect.ControllerServiceClient, commit string, execErr error) {
+	req := &controllerv1.ReportSyncRequest{
+		Commit: commit,
+		Result: controllerv1.SyncResult_SYNC_RESULT_SUCCESS,
+	}
+	if execErr != nil {
+		req.Result = controllerv1.SyncResult_SYNC_RESULT_FAILURE
+		for _, seedErr := range seedErrors(execErr) {
+			req.FailingSeeds = append(req.FailingSeeds, &controllerv1.SeedFailure{
+				Hash:        seedErr.Seed.Metadata.Hash,
+				DisplayName: seedErr.Seed.Metadata.DisplayName,
+				Error:       seedErr.Error(),
+			})
+		}
+	}
+
+	// Not derived from the sync's context, a cancelled sync should still be reported
+	ctx, cancel := context.WithTimeout(context.Background(), reportSyncTimeout)
+	defer cancel()
+
+	a.log.Debug().Msg("reporting sync result")
+	if _, err := client.ReportSync(ctx, connect.NewRequest(req)); err != nil {
+		a.log.Warn().Err(err).Msg("error reporting sync result")
 	}
+}
 
-	if _, err := client.ForceRefresh(ctx, &connect.Request[controllerv1.ForceRefreshRequest]{}); err != nil {
-		return fmt.Errorf("error forcing refresh: %w", err)
+// seedErrors unpacks the (possibly joined) error returned from executeSeeds into its per-seed failures
+func seedErrors(err error) []*SeedError {
+	var out []*SeedError
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			out = append(out, seedErrors(e)...)
+		}
+		return out
 	}
 
-	return nil
+	var seedErr *SeedError
+	if errors.As(err, &seedErr) {
+		out = append(out, seedErr)
+	}
+
+	return out
 }
 
 func (a *Agent) getAccessToken(client controllerv1connect.ControllerServiceClient) (string, error) {
@@ -182,8 +300,91 @@ func (a *Agent) getAccessToken(client controllerv1connect.ControllerServiceClien
 	return a.token, nil
 }
 
-func (a *Agent) executeSeeds(ctx context.Context, seeds []*controllerv1.Seed) error {
-	var errs []error
+// seedJob is a single seed scheduled for execution
+type seedJob struct {
+	index             int
+	seed              *controllerv1.Seed
+	msg               string
+	executeFunc       func(context.Context, *controllerv1.Seed) (*InventoryRow, error)
+	skipInventoryFunc func(*controllerv1.Seed) bool
+	preExecuteFunc    func() error
+	// provider seeds install tooling (system packages, the go toolchain) that later seeds may rely on, so every seed
+	// waits for all providers that come before it to finish
+	provider bool
+	// paths are the files or directories the seed writes, seeds writing overlapping paths run in config order
+	paths []string
+	// after are the done channels of the earlier seeds this one has to wait for
+	after []chan struct{}
+	done  chan struct{}
+}
+
+// seedPaths returns the files or directories a seed writes to
+func seedPaths(seed *controllerv1.Seed) []string {
+	switch concrete := seed.Element.(type) {
+	case *controllerv1.Seed_ConfigFile:
+		return []string{concrete.ConfigFile.Destination}
+	case *controllerv1.Seed_GithubRelease:
+		return []string{concrete.GithubRelease.DestinationDirectory}
+	case *controllerv1.Seed_GitRepo:
+		return []string{concrete.GitRepo.Location}
+	case *controllerv1.Seed_UrlDownload:
+		return []string{concrete.UrlDownload.DestinationDirectory}
+	default:
+		return nil
+	}
+}
+
+// pathsOverlap reports if a and b are the same path, or one contains the other
+func pathsOverlap(a string, b string) bool {
+	within := func(child string, parent string) bool {
+		rel, err := filepath.Rel(parent, child)
+		return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+	}
+	a, b = filepath.Clean(a), filepath.Clean(b)
+	return within(a, b) || within(b, a)
+}
+
+// linkSeedJobs works out which earlier jobs each job has to wait for: the closest provider before it, and every
+// earlier job writing an overlapping path, i.e a config_file inside a git_repo checkout or two downloads into the same
+// directory
+func linkSeedJobs(jobs []*seedJob) {
+	var lastProvider *seedJob
+	for i, job := range jobs {
+		// Waiting on the most recent provider also covers every provider before it, since it waited on those
+		if lastProvider != nil {
+			job.after = append(job.after, lastProvider.done)
+		}
+		for _, earlier := range jobs[:i] {
+			if earlier == lastProvider {
+				continue
+			}
+			if slices.ContainsFunc(job.paths, func(path string) bool {
+				return slices.ContainsFunc(earlier.paths, func(other string) bool {
+					return pathsOverlap(path, other)
+				})
+			}) {
+				job.after = append(job.after, earlier.done)
+			}
+		}
+		if job.provider {
+			lastProvider = job
+		}
+	}
+}
+
+// executeSeeds runs the given seeds, up to seedConcurrency at a time. Seeds keep their relative order with respect to
+// any provider seed before them, which also serializes system package installs, and to any earlier seed writing an
+// overlapping path
+func (a *Agent) executeSeeds(ctx context.Context, seeds []*controllerv1.Seed, emit SyncEventFunc) error {
+	emitMu := &sync.Mutex{}
+	safeEmit := func(event *pbv1.SyncEvent) {
+		if emit == nil {
+			return
+		}
+		emitMu.Lock()
+		defer emitMu.Unlock()
+		emit(event)
+	}
 
 	noopSkip := func(_ *controllerv1.Seed) bool {
 		return false
@@ -199,94 +400,178 @@ func (a *Agent) executeSeeds(ctx context.Context, seeds []*controllerv1.Seed) er
 		return sysUpdateFunc()
 	})
 
-	for _, seed := range seeds {
-		namedError := func(err error, ctx string) error {
-			return fmt.Errorf("%v: %v, %w", seed.Metadata.DisplayName, ctx, err)
-		}
+	safeEmit(&pbv1.SyncEvent{
+		Kind:    pbv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_STARTED,
+		Total:   int32(len(seeds)), //nolint:gosec // not syncing 2 billion seeds
+		Message: fmt.Sprintf("syncing %v seeds", len(seeds)),
+	})
 
-		var executeFunc func(context.Context, *controllerv1.Seed) (*InventoryRow, error)
-		var skipInventoryFunc func(*controllerv1.Seed) bool
-		var preExecuteFunc func() error
-		var msg string
+	jobs := []*seedJob{}
+	for i, seed := range seeds {
+		job := &seedJob{
+			index:             i,
+			seed:              seed,
+			skipInventoryFunc: noopSkip,
+			preExecuteFunc:    noopPreExecute,
+			paths:             seedPaths(seed),
+			done:              make(chan struct{}),
+		}
 
 		switch concrete := seed.Element.(type) {
 		case *controllerv1.Seed_ConfigFile:
-			msg = fmt.Sprintf("rendering config file %v", seed.Metadata.DisplayName)
-			executeFunc = a.executeSeed_configFile
-			skipInventoryFunc = noopSkip
-			preExecuteFunc = noopPreExecute
+			job.msg = fmt.Sprintf("rendering config file %v", seed.Metadata.DisplayName)
+			job.executeFunc = a.executeSeed_configFile
 		case *controllerv1.Seed_GithubRelease:
-			msg = fmt.Sprintf("downloading github_release %v", seed.Metadata.DisplayName)
-			executeFunc = a.executeSeed_githubRelease
-			skipInventoryFunc = noopSkip
-			preExecuteFunc = noopPreExecute
+			job.msg = fmt.Sprintf("downloading github_release %v", seed.Metadata.DisplayName)
+			job.executeFunc = a.executeSeed_githubRelease
 		case *controllerv1.Seed_SystemPackage:
-			msg = fmt.Sprintf("installing system_package %v", seed.Metadata.DisplayName)
-			executeFunc = a.executeSeed_systemPackage
-			skipInventoryFunc = noopSkip
-			preExecuteFunc = preSystemUpdate
+			job.msg = fmt.Sprintf("installing system_package %v", seed.Metadata.DisplayName)
+			job.executeFunc = a.executeSeed_systemPackage
+			job.preExecuteFunc = preSystemUpdate
+			job.provider = true
 		case *controllerv1.Seed_GitRepo:
-			msg = fmt.Sprintf("cloning git_repo %v", seed.Metadata.DisplayName)
-			executeFunc = a.executeSeed_gitRepo
-			skipInventoryFunc = noopSkip
-			preExecuteFunc = noopPreExecute
+			job.msg = fmt.Sprintf("cloning git_repo %v", seed.Metadata.DisplayName)
+			job.executeFunc = a.executeSeed_gitRepo
 		case *controllerv1.Seed_Golang:
-			msg = fmt.Sprintf("installing %v", seed.Metadata.DisplayName)
-			executeFunc = a.executeSeed_golang
-			skipInventoryFunc = noopSkip
-			preExecuteFunc = noopPreExecute
+			job.msg = fmt.Sprintf("installing %v", seed.Metadata.DisplayName)
+			job.executeFunc = a.executeSeed_golang
+			job.provider = true
 		case *controllerv1.Seed_GoInstall:
-			msg = fmt.Sprintf("installing go binary %v", seed.Metadata.DisplayName)
-			executeFunc = a.executeSeed_goInstall
+			job.msg = fmt.Sprintf("installing go binary %v", seed.Metadata.DisplayName)
+			job.executeFunc = a.executeSeed_goInstall
 			// If we're not specifying a version, that means "latest", so dont check inventory to guarantee that we try
 			// it again
-			skipInventoryFunc = func(s *controllerv1.Seed) bool {
+			job.skipInventoryFunc = func(s *controllerv1.Seed) bool {
 				return s.GetGoInstall().Version == nil
 			}
-			preExecuteFunc = noopPreExecute
 		case *controllerv1.Seed_UrlDownload:
-			msg = fmt.Sprintf("downloading %v", seed.Metadata.DisplayName)
-			executeFunc = a.executeSeed_urlDownload
-			skipInventoryFunc = noopSkip
-			preExecuteFunc = noopPreExecute
+			job.msg = fmt.Sprintf("downloading %v", seed.Metadata.DisplayName)
+			job.executeFunc = a.executeSeed_urlDownload
 		default:
 			a.log.Warn().Msgf("dropping unknown seed type %T", concrete)
+			a.seedEvent(safeEmit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_SKIPPED, fmt.Sprintf("dropping unknown seed type %T", concrete), nil)
 			continue
 		}
 
-		a.log.Info().Msg(msg)
+		jobs = append(jobs, job)
+	}
 
-		if !skipInventoryFunc(seed) {
-			row, err := a.inventory.GetRow(ctx, seed.Metadata.Hash)
-			if err != nil {
-				return namedError(err, "error reading inventory")
-			}
-			if row != nil {
-				a.log.Debug().Msg("already exists in inventory, skipping")
-				continue
+	// Errors that should stop the sync entirely, rather than just failing a single seed
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	errs := make([]error, len(seeds))
+	sem := make(chan struct{}, a.seedConcurrency)
+	wg := sync.WaitGroup{}
+
+	// Seeds are dispatched in order, waiting on whatever they depend on first
+	linkSeedJobs(jobs)
+dispatch:
+	for _, job := range jobs {
+		for _, after := range job.after {
+			select {
+			case <-after:
+			case <-ctx.Done():
+				break dispatch
 			}
 		}
 
-		if err := preExecuteFunc(); err != nil {
-			return namedError(err, "error executing pre-execute function")
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break dispatch
 		}
 
-		row, err := executeFunc(ctx, seed)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(job.done)
+			defer func() { <-sem }()
+
+			fatal, err := a.executeSeedJob(ctx, job, safeEmit)
+			if fatal {
+				cancel(err)
+			}
+			errs[job.index] = err
+		}()
+	}
+
+	wg.Wait()
+
+	if cause := context.Cause(ctx); cause != nil {
+		return cause
+	}
+
+	return errors.Join(errs...)
+}
+
+// executeSeedJob runs a single seed, returning if the error should abort the remainder of the sync
+func (a *Agent) executeSeedJob(ctx context.Context, job *seedJob, emit SyncEventFunc) (bool, error) {
+	seed := job.seed
+	namedError := func(err error, ctx string) error {
+		return &SeedError{Seed: seed, Context: ctx, Err: err}
+	}
+
+	a.log.Info().Msg(job.msg)
+	a.seedEvent(emit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_STARTED, job.msg, nil)
+
+	if !job.skipInventoryFunc(seed) {
+		a.inventoryMu.Lock()
+		row, err := a.inventory.GetRow(ctx, seed.Metadata.Hash)
+		a.inventoryMu.Unlock()
 		if err != nil {
-			errs = append(errs, namedError(err, "error executing"))
-			continue
+			err = namedError(err, "error reading inventory")
+			a.seedEvent(emit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_FAILED, "error reading inventory", err)
+			return true, err
 		}
-
 		if row != nil {
-			row.Hash = seed.Metadata.Hash
-			if err := a.inventory.WriteRow(ctx, *row); err != nil {
-				errs = append(errs, namedError(err, "error writing to inventory"))
-				continue
-			}
+			a.log.Debug().Msgf("%v already exists in inventory, skipping", seed.Metadata.DisplayName)
+			a.seedEvent(emit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_SKIPPED, "already exists in inventory", nil)
+			return false, nil
 		}
 	}
 
-	return errors.Join(errs...)
+	if err := job.preExecuteFunc(); err != nil {
+		err = namedError(err, "error executing pre-execute function")
+		a.seedEvent(emit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_FAILED, "error executing pre-execute function", err)
+		return true, err
+	}
+
+	row, err := job.executeFunc(ctx, seed)
+	if err != nil {
+		err = namedError(err, "error executing")
+		a.seedEvent(emit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_FAILED, "error executing", err)
+		return false, err
+	}
+
+	if row != nil {
+		row.Hash = seed.Metadata.Hash
+		a.inventoryMu.Lock()
+		err := a.inventory.WriteRow(ctx, *row)
+		a.inventoryMu.Unlock()
+		if err != nil {
+			err = namedError(err, "error writing to inventory")
+			a.seedEvent(emit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_FAILED, "error writing to inventory", err)
+			return false, err
+		}
+	}
+
+	a.seedEvent(emit, job, pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_SUCCEEDED, "done", nil)
+	return false, nil
+}
+
+func (a *Agent) seedEvent(emit SyncEventFunc, job *seedJob, kind pbv1.SyncEventKind, msg string, err error) {
+	event := &pbv1.SyncEvent{
+		Kind:        kind,
+		Index:       int32(job.index + 1), //nolint:gosec // not syncing 2 billion seeds
+		Hash:        job.seed.Metadata.Hash,
+		DisplayName: job.seed.Metadata.DisplayName,
+		Message:     msg,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	emit(event)
 }
 
 func (a *Agent) executeSeed_configFile(ctx context.Context, pbseed *controllerv1.Seed) (*InventoryRow, error) {
@@ -327,6 +612,10 @@ func (a *Agent) executeSeed_githubRelease(ctx context.Context, pbseed *controlle
 		PreserveArchive:      seed.ArchiveRelease,
 		NameOverride:         seed.NameOverride,
 		BinaryRegex:          seed.BinaryRegex,
+		ExpectedDigest:       sha256Digest(seed.Sha256),
+		Signature:            downloadSignature(seed.Signature),
+		Cache:                a.downloadCache,
+		Retry:                a.downloadRetry,
 	})
 	if err != nil {
 		return nil, err
@@ -504,6 +793,10 @@ func (a *Agent) executeSeed_urlDownload(ctx context.Context, pbseed *controllerv
 		DestinationDirectory: seed.DestinationDirectory,
 		NameOverride:         seed.NameOverride,
 		PreserveArchive:      seed.ArchiveRelease,
+		ExpectedDigest:       sha256Digest(seed.Sha256),
+		Signature:            downloadSignature(seed.Signature),
+		Cache:                a.downloadCache,
+		Retry:                a.downloadRetry,
 	})
 	if err != nil {
 		return nil, err
@@ -2,8 +2,13 @@ package agent
 
 import (
 	"context"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/agent/v1"
 	controllerv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
 	"github.com/stretchr/testify/require"
 )
@@ -60,7 +65,237 @@ func TestSystemPackageUpdateRunsOnce(t *testing.T) {
 				},
 			},
 		},
-	}))
+	}, nil))
 
 	require.Equal(t, 1, count)
 }
+
+func TestSeedErrors(t *testing.T) {
+	a := NewAgent(AgentConfig{
+		Inventory: NewNoopInventory(NoopInventoryConfig{}),
+	})
+
+	badSeed := func(name string) *controllerv1.Seed {
+		return &controllerv1.Seed{
+			Metadata: &controllerv1.Seed_Metadata{
+				DisplayName: name,
+				Hash:        name + "-hash",
+			},
+			Element: &controllerv1.Seed_ConfigFile{
+				ConfigFile: &controllerv1.ConfigFile{
+					Destination: filepath.Join(t.TempDir(), name),
+					Mode:        "not-a-mode",
+				},
+			},
+		}
+	}
+
+	err := a.executeSeeds(context.Background(), []*controllerv1.Seed{
+		badSeed("seed-one"),
+		badSeed("seed-two"),
+	}, nil)
+	require.Error(t, err)
+
+	got := seedErrors(err)
+	require.Len(t, got, 2)
+	require.Equal(t, "seed-one-hash", got[0].Seed.Metadata.Hash)
+	require.Equal(t, "seed-two-hash", got[1].Seed.Metadata.Hash)
+}
+
+func TestExecuteSeedsEvents(t *testing.T) {
+	a := NewAgent(AgentConfig{
+		Inventory: NewNoopInventory(NoopInventoryConfig{}),
+		// One at a time so events arrive in a deterministic order
+		SeedConcurrency: 1,
+	})
+
+	configFile := func(name string, mode string) *controllerv1.Seed {
+		return &controllerv1.Seed{
+			Metadata: &controllerv1.Seed_Metadata{
+				DisplayName: name,
+				Hash:        name + "-hash",
+			},
+			Element: &controllerv1.Seed_ConfigFile{
+				ConfigFile: &controllerv1.ConfigFile{
+					Content:     "some-content",
+					Destination: filepath.Join(t.TempDir(), name),
+					Mode:        mode,
+				},
+			},
+		}
+	}
+
+	events := []*pbv1.SyncEvent{}
+	err := a.executeSeeds(
+		context.Background(),
+		[]*controllerv1.Seed{
+			configFile("good", "644"),
+			configFile("bad", "not-a-mode"),
+		},
+		func(event *pbv1.SyncEvent) {
+			events = append(events, event)
+		},
+	)
+	require.Error(t, err)
+
+	type summary struct {
+		Kind  pbv1.SyncEventKind
+		Index int32
+		Name  string
+	}
+	got := []summary{}
+	for _, event := range events {
+		got = append(got, summary{Kind: event.Kind, Index: event.Index, Name: event.DisplayName})
+	}
+
+	require.Equal(
+		t,
+		[]summary{
+			{Kind: pbv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_STARTED},
+			{Kind: pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_STARTED, Index: 1, Name: "good"},
+			{Kind: pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_SUCCEEDED, Index: 1, Name: "good"},
+			{Kind: pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_STARTED, Index: 2, Name: "bad"},
+			{Kind: pbv1.SyncEventKind_SYNC_EVENT_KIND_SEED_FAILED, Index: 2, Name: "bad"},
+		},
+		got,
+	)
+	require.Equal(t, int32(2), events[0].Total)
+	require.NotEmpty(t, events[4].Error)
+}
+
+func TestExecuteSeedsConcurrently(t *testing.T) {
+	unitTestSystemUpdateFunc = func() error {
+		return nil
+	}
+	t.Cleanup(func() {
+		unitTestSystemUpdateFunc = nil
+	})
+
+	type span struct {
+		start time.Time
+		end   time.Time
+	}
+
+	mu := &sync.Mutex{}
+	spans := map[string]*span{}
+	inFlight := 0
+	maxInFlight := 0
+	unitTestExecuteFunc = func(bin string, args ...string) (string, string, error) {
+		// Either "go install <pkg>@<version>" or "sh -c <apt install cmd> <pkg>"
+		fields := strings.Fields(args[len(args)-1])
+		name := strings.Split(fields[len(fields)-1], "@")[0]
+
+		mu.Lock()
+		spans[name] = &span{start: time.Now()}
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(25 * time.Millisecond)
+
+		mu.Lock()
+		spans[name].end = time.Now()
+		inFlight--
+		mu.Unlock()
+
+		return "", "", nil
+	}
+	t.Cleanup(func() {
+		unitTestExecuteFunc = nil
+	})
+
+	aptSeed := func(name string) *controllerv1.Seed {
+		return &controllerv1.Seed{
+			Metadata: &controllerv1.Seed_Metadata{DisplayName: name, Hash: name},
+			Element: &controllerv1.Seed_SystemPackage{
+				SystemPackage: &controllerv1.SystemPackage{
+					Pkg: &controllerv1.SystemPackage_Apt{
+						Apt: &controllerv1.SystemPackage_AptPkg{Name: name},
+					},
+				},
+			},
+		}
+	}
+	goSeed := func(name string) *controllerv1.Seed {
+		return &controllerv1.Seed{
+			Metadata: &controllerv1.Seed_Metadata{DisplayName: name, Hash: name},
+			Element: &controllerv1.Seed_GoInstall{
+				GoInstall: &controllerv1.GoInstall{Package: name},
+			},
+		}
+	}
+
+	a := NewAgent(AgentConfig{
+		Inventory:       NewNoopInventory(NoopInventoryConfig{}),
+		SeedConcurrency: 3,
+	})
+
+	require.NoError(t, a.executeSeeds(context.Background(), []*controllerv1.Seed{
+		aptSeed("pkg-one"),
+		goSeed("go-a"),
+		goSeed("go-b"),
+		goSeed("go-c"),
+		aptSeed("pkg-two"),
+		goSeed("go-d"),
+	}, nil))
+
+	require.Len(t, spans, 6)
+	require.LessOrEqual(t, maxInFlight, 3)
+	require.GreaterOrEqual(t, maxInFlight, 2)
+
+	// Everything waits on the system package before it
+	for _, name := range []string{"go-a", "go-b", "go-c", "pkg-two", "go-d"} {
+		require.False(t, spans[name].start.Before(spans["pkg-one"].end), "%v started before pkg-one finished", name)
+	}
+	// And the second system package
+	require.False(t, spans["go-d"].start.Before(spans["pkg-two"].end), "go-d started before pkg-two finished")
+}
+
+func TestLinkSeedJobs(t *testing.T) {
+	t.Parallel()
+
+	configFileSeed := func(name string, destination string) *controllerv1.Seed {
+		return &controllerv1.Seed{
+			Metadata: &controllerv1.Seed_Metadata{DisplayName: name, Hash: name},
+			Element: &controllerv1.Seed_ConfigFile{
+				ConfigFile: &controllerv1.ConfigFile{Destination: destination},
+			},
+		}
+	}
+	gitRepoSeed := func(name string, location string) *controllerv1.Seed {
+		return &controllerv1.Seed{
+			Metadata: &controllerv1.Seed_Metadata{DisplayName: name, Hash: name},
+			Element: &controllerv1.Seed_GitRepo{
+				GitRepo: &controllerv1.GitRepo{Location: location},
+			},
+		}
+	}
+	urlDownloadSeed := func(name string, directory string) *controllerv1.Seed {
+		return &controllerv1.Seed{
+			Metadata: &controllerv1.Seed_Metadata{DisplayName: name, Hash: name},
+			Element: &controllerv1.Seed_UrlDownload{
+				UrlDownload: &controllerv1.UrlDownload{DestinationDirectory: directory},
+			},
+		}
+	}
+
+	seeds := []*controllerv1.Seed{
+		gitRepoSeed("dotfiles", "/home/user/dotfiles"),
+		configFileSeed("inside-checkout", "/home/user/dotfiles/.config/thing.yaml"),
+		configFileSeed("elsewhere", "/home/user/dotfiles-other/thing.yaml"),
+		urlDownloadSeed("download-one", "/home/user/bin"),
+		urlDownloadSeed("download-two", "/home/user/bin/"),
+	}
+	jobs := []*seedJob{}
+	for i, seed := range seeds {
+		jobs = append(jobs, &seedJob{index: i, seed: seed, paths: seedPaths(seed), done: make(chan struct{})})
+	}
+
+	linkSeedJobs(jobs)
+
+	require.Empty(t, jobs[0].after)
+	require.Equal(t, []chan struct{}{jobs[0].done}, jobs[1].after)
+	require.Empty(t, jobs[2].after)
+	require.Empty(t, jobs[3].after)
+	require.Equal(t, []chan struct{}{jobs[3].done}, jobs[4].after)
+}
@@ -32,9 +32,22 @@ const (
 	PrivateKeyPath    = "private_key.path"
 	NodeID            = "node.id"
 	PollInterval      = "poll_interval"
+	SeedConcurrency   = "seed_concurrency"
+
+	AgentAuthSecret        = "agent_auth.secret"          //nolint:gosec // its env config, relax
+	AgentAuthPublicKeyPath = "agent_auth.public_key.path" //nolint:gosec // its env config, relax
 
 	StorageType  = "storage.type"
 	SqliteDBPath = "sqlite.db_path"
+
+	DownloadCacheEnabled   = "download_cache.enabled"
+	DownloadCacheDirectory = "download_cache.directory"
+	DownloadCacheMaxSizeMB = "download_cache.max_size_mb"
+
+	DownloadMaxAttempts    = "download.max_attempts"
+	DownloadInitialBackoff = "download.initial_backoff"
+	DownloadMaxBackoff     = "download.max_backoff"
+	DownloadAttemptTimeout = "download.attempt_timeout"
 )
 
 var (
@@ -48,6 +61,16 @@ var (
 	DefaultStorageType = StorageKindSqlite.String()
 
 	DefaultPollInterval = "0s"
+
+	DefaultSeedConcurrency = 4
+
+	DefaultDownloadCacheEnabled   = true
+	DefaultDownloadCacheMaxSizeMB = 2048
+
+	DefaultDownloadMaxAttempts    = 4
+	DefaultDownloadInitialBackoff = "1s"
+	DefaultDownloadMaxBackoff     = "30s"
+	DefaultDownloadAttemptTimeout = "15m"
 )
 
 func SetServiceDefaults() {
@@ -68,7 +91,15 @@ func SetWorkerDefaults() error {
 	}
 
 	viper.SetDefault(StorageType, DefaultStorageType)
+	viper.SetDefault(SeedConcurrency, DefaultSeedConcurrency)
 	viper.SetDefault(SqliteDBPath, filepath.Join(cachedir, "plantr", "storage.db"))
+	viper.SetDefault(DownloadCacheEnabled, DefaultDownloadCacheEnabled)
+	viper.SetDefault(DownloadCacheDirectory, filepath.Join(cachedir, "plantr", "downloads"))
+	viper.SetDefault(DownloadCacheMaxSizeMB, DefaultDownloadCacheMaxSizeMB)
+	viper.SetDefault(DownloadMaxAttempts, DefaultDownloadMaxAttempts)
+	viper.SetDefault(DownloadInitialBackoff, DefaultDownloadInitialBackoff)
+	viper.SetDefault(DownloadMaxBackoff, DefaultDownloadMaxBackoff)
+	viper.SetDefault(DownloadAttemptTimeout, DefaultDownloadAttemptTimeout)
 
 	return nil
 }
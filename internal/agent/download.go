@@ -1,10 +1,15 @@
 package agent
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -14,9 +19,15 @@ import (
 	"github.com/mholt/archives"
 	"github.com/nicjohnson145/hlp"
 	"github.com/nicjohnson145/hlp/set"
+	controllerv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/signature"
 	"github.com/rs/zerolog"
 )
 
+var (
+	ErrChecksumMismatchError = errors.New("checksum mismatch")
+)
+
 var (
 	archiveExtensions = set.New(
 		".gz",
@@ -35,6 +46,21 @@ type DownloadRequest struct {
 	PreserveArchive      bool
 	NameOverride         *string
 	BinaryRegex          *string
+	// Cache, if set, is checked before hitting the network and populated after a successful download
+	Cache *DownloadCache
+	// ExpectedDigest is the digest the download is expected to have, in the form "sha256:<hex>", if known. The download
+	// is verified against it before anything is extracted or installed, and it forms part of the cache key so a changed
+	// digest for the same URL is never served from cache
+	ExpectedDigest string
+	// Signature, if set, is a detached signature the download must verify against before it is extracted or installed
+	Signature *DownloadSignature
+	Retry     DownloadRetryConfig
+}
+
+type DownloadSignature struct {
+	Kind      string
+	PublicKey string
+	URL       string
 }
 
 type DownloadResponse struct {
@@ -47,28 +73,59 @@ func DownloadFromUrl(ctx context.Context, req *DownloadRequest) (*DownloadRespon
 		return nil, fmt.Errorf("error creating destination directory: %w", err)
 	}
 
-	req.Logger.Trace().Msg("creating temp directory to land download")
-	tmpDir, err := os.MkdirTemp("", "plantr-agent")
-	if err != nil {
-		return nil, fmt.Errorf("error creating temp directory: %w", err)
-	}
-	defer os.RemoveAll(tmpDir)
-
 	filename := filepath.Base(req.URL)
-	tmpPath := filepath.Join(tmpDir, filename)
-	builder := requests.
-		URL(req.URL).
-		ToFile(tmpPath).
-		Client(req.Client)
-
-	if req.RequestModFunc != nil {
-		builder = req.RequestModFunc(builder)
+	fetch := func(path string) error {
+		if err := fetchWithRetry(ctx, req, path); err != nil {
+			return err
+		}
+		if err := verifyDigest(path, req.ExpectedDigest); err != nil {
+			req.Logger.Error().Err(err).Msgf("integrity check failed for %v, removing download", req.URL)
+			if rmErr := os.Remove(path); rmErr != nil {
+				req.Logger.Warn().Err(rmErr).Msg("error removing download")
+			}
+			return err
+		}
+		if err := verifySignature(ctx, req, path); err != nil {
+			req.Logger.Error().Err(err).Msgf("signature check failed for %v, removing download", req.URL)
+			if rmErr := os.Remove(path); rmErr != nil {
+				req.Logger.Warn().Err(rmErr).Msg("error removing download")
+			}
+			return err
+		}
+		return nil
 	}
 
-	req.Logger.Trace().Msg("executing request")
-	if err := builder.Fetch(ctx); err != nil {
-		return nil, fmt.Errorf("error executing download request: %w", err)
+	var downloaded *os.File
+	if req.Cache != nil {
+		// Only downloads that passed verification are ever cached, so the signature config forms part of the key just
+		// like the digest, a newly added or rotated key is never satisfied by an entry verified under the old one
+		cacheDigest := req.ExpectedDigest
+		if req.Signature != nil {
+			cacheDigest = strings.Join([]string{cacheDigest, req.Signature.Kind, req.Signature.PublicKey, req.Signature.URL}, "\n")
+		}
+		fl, err := req.Cache.Fetch(req.URL, cacheDigest, filename, fetch)
+		if err != nil {
+			return nil, err
+		}
+		downloaded = fl
+	} else {
+		req.Logger.Trace().Msg("creating temp directory to land download")
+		tmpDir, err := os.MkdirTemp("", "plantr-agent")
+		if err != nil {
+			return nil, fmt.Errorf("error creating temp directory: %w", err)
+		}
+		defer os.RemoveAll(tmpDir)
+
+		tmpPath := filepath.Join(tmpDir, filename)
+		if err := fetch(tmpPath); err != nil {
+			return nil, err
+		}
+		downloaded, err = os.Open(tmpPath)
+		if err != nil {
+			return nil, fmt.Errorf("error opening file for reading: %w", err)
+		}
 	}
+	defer downloaded.Close()
 
 	var extractor archives.Extractor
 	var stream io.Reader
@@ -78,14 +135,8 @@ func DownloadFromUrl(ctx context.Context, req *DownloadRequest) (*DownloadRespon
 	isArchive := archiveExtensions.Contains(filepath.Ext(filename))
 
 	if isArchive {
-		req.Logger.Trace().Msg("archive file detected, identifying and opening")
-		fl, err := os.Open(tmpPath)
-		if err != nil {
-			return nil, fmt.Errorf("error opening file for reading: %w", err)
-		}
-		defer fl.Close()
-
-		a, s, err := archives.Identify(ctx, filename, fl)
+		req.Logger.Trace().Msg("archive file detected, identifying")
+		a, s, err := archives.Identify(ctx, filename, downloaded)
 		if err != nil {
 			return nil, fmt.Errorf("error detecting archive type: %w", err)
 		}
@@ -112,7 +163,7 @@ func DownloadFromUrl(ctx context.Context, req *DownloadRequest) (*DownloadRespon
 			return nil, fmt.Errorf("error making target extraction directory: %w", err)
 		}
 
-		err = extractor.Extract(ctx, stream, func(ctx context.Context, info archives.FileInfo) error {
+		err := extractor.Extract(ctx, stream, func(ctx context.Context, info archives.FileInfo) error {
 			infoPath := strings.TrimPrefix(info.NameInArchive, targetName+"/")
 			// i.e its the top level directory
 			if infoPath == "" {
@@ -209,12 +260,12 @@ func DownloadFromUrl(ctx context.Context, req *DownloadRequest) (*DownloadRespon
 		binaryContent = executableFiles[name]
 		destName = filepath.Base(name)
 	} else { // the asset is already only a single binary
-		content, err := os.ReadFile(tmpPath)
+		content, err := io.ReadAll(downloaded)
 		if err != nil {
 			return nil, fmt.Errorf("error reading file contents: %w", err)
 		}
 		binaryContent = content
-		destName = filepath.Base(tmpPath)
+		destName = filename
 	}
 
 	if req.NameOverride != nil {
@@ -231,6 +282,107 @@ func DownloadFromUrl(ctx context.Context, req *DownloadRequest) (*DownloadRespon
 	}, nil
 }
 
+func sha256Digest(sum *string) string {
+	if sum == nil || *sum == "" {
+		return ""
+	}
+	return "sha256:" + *sum
+}
+
+// verifyDigest checks the file at path against a digest of the form "sha256:<hex>". An empty digest always passes
+func verifyDigest(path string, digest string) error {
+	if digest == "" {
+		return nil
+	}
+
+	algorithm, want, ok := strings.Cut(digest, ":")
+	if !ok || algorithm != "sha256" {
+		return fmt.Errorf("unsupported digest %q", digest)
+	}
+
+	fl, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening download for verification: %w", err)
+	}
+	defer fl.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, fl); err != nil {
+		return fmt.Errorf("error hashing download: %w", err)
+	}
+
+	got := hex.EncodeToString(hasher.Sum(nil))
+	if !strings.EqualFold(got, want) {
+		return fmt.Errorf("%w: expected sha256 %v, got %v", ErrChecksumMismatchError, want, got)
+	}
+
+	return nil
+}
+
+// verifySignature fetches the detached signature for the download and checks the file at path against it. No signature
+// always passes
+func verifySignature(ctx context.Context, req *DownloadRequest, path string) error {
+	if req.Signature == nil {
+		return nil
+	}
+
+	client := req.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var sig bytes.Buffer
+	builder := requests.
+		URL(req.Signature.URL).
+		Client(client).
+		ToBytesBuffer(&sig)
+	// The request mod adds the release host's credentials, which mustn't leak to wherever else the signature lives
+	if req.RequestModFunc != nil && sameOrigin(req.URL, req.Signature.URL) {
+		builder = req.RequestModFunc(builder)
+	}
+
+	req.Logger.Trace().Msgf("fetching signature from %v", req.Signature.URL)
+	if err := builder.Fetch(ctx); err != nil {
+		return fmt.Errorf("error fetching signature: %w", err)
+	}
+
+	fl, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening download for verification: %w", err)
+	}
+	defer fl.Close()
+
+	if err := signature.Verify(req.Signature.Kind, req.Signature.PublicKey, fl, sig.Bytes()); err != nil {
+		return fmt.Errorf("error verifying %v signature for %v: %w", req.Signature.Kind, req.URL, err)
+	}
+
+	return nil
+}
+
+// sameOrigin reports if both urls have the same scheme and host
+func sameOrigin(a string, b string) bool {
+	aURL, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	bURL, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return aURL.Scheme == bURL.Scheme && strings.EqualFold(aURL.Host, bURL.Host)
+}
+
+func downloadSignature(sig *controllerv1.Signature) *DownloadSignature {
+	if sig == nil {
+		return nil
+	}
+	return &DownloadSignature{
+		Kind:      sig.Kind,
+		PublicKey: sig.PublicKey,
+		URL:       sig.Url,
+	}
+}
+
 func fullTrimSuffix(name string) string {
 	ext := "starter"
 	base := name
@@ -0,0 +1,243 @@
+package agent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const downloadCacheStagingPrefix = ".staging-"
+
+type DownloadCacheConfig struct {
+	Logger    zerolog.Logger
+	Directory string
+	// MaxBytes bounds the total size of the cache, least recently used entries are evicted past it. Zero or less
+	// means unbounded
+	MaxBytes int64
+	NowFunc  func() time.Time // for unit tests
+}
+
+func NewDownloadCache(conf DownloadCacheConfig) *DownloadCache {
+	d := &DownloadCache{
+		log:       conf.Logger,
+		directory: conf.Directory,
+		maxBytes:  conf.MaxBytes,
+		nowFunc:   conf.NowFunc,
+		mu:        &sync.Mutex{},
+	}
+
+	if d.nowFunc == nil {
+		d.nowFunc = time.Now
+	}
+
+	return d
+}
+
+// DownloadCache is a content-addressed store of downloaded files, keyed by URL and expected digest. Entries live at
+// <directory>/<key>/<filename>, and the modification time of the file doubles as its last use for LRU eviction
+type DownloadCache struct {
+	log       zerolog.Logger
+	directory string
+	maxBytes  int64
+	nowFunc   func() time.Time
+	mu        *sync.Mutex
+}
+
+type downloadCacheEntry struct {
+	key      string
+	size     int64
+	lastUsed time.Time
+}
+
+func downloadCacheKey(url string, digest string) string {
+	hash := sha256.Sum256([]byte(url + "\n" + digest))
+	return hex.EncodeToString(hash[:])
+}
+
+// Fetch opens the cached copy of url, calling download to populate the cache on a miss. download is given the path the
+// file should be written to. The entry is opened under the cache lock, so a concurrent eviction or prune can't remove it
+// before the caller reads it. The caller must close the file
+func (d *DownloadCache) Fetch(url string, digest string, filename string, download func(path string) error) (*os.File, error) {
+	key := downloadCacheKey(url, digest)
+	entryPath := filepath.Join(d.directory, key, filename)
+
+	fl, err := d.open(entryPath)
+	if err != nil {
+		return nil, err
+	}
+	if fl != nil {
+		d.log.Debug().Msgf("download cache hit for %v", url)
+		return fl, nil
+	}
+	d.log.Debug().Msgf("download cache miss for %v", url)
+
+	if err := os.MkdirAll(d.directory, 0775); err != nil {
+		return nil, fmt.Errorf("error creating download cache directory: %w", err)
+	}
+
+	// Land the download next to the cache so the final rename doesn't cross filesystems, and a failed download never
+	// leaves a partial entry behind
+	stagingDir, err := os.MkdirTemp(d.directory, downloadCacheStagingPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("error creating staging directory: %w", err)
+	}
+	defer os.RemoveAll(stagingDir)
+
+	if err := download(filepath.Join(stagingDir, filename)); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := os.Rename(stagingDir, filepath.Join(d.directory, key)); err != nil {
+		// Somebody else populated the same entry while we were downloading, theirs is just as good
+		if _, statErr := os.Stat(entryPath); statErr != nil {
+			return nil, fmt.Errorf("error committing download to cache: %w", err)
+		}
+	}
+
+	now := d.nowFunc()
+	if err := os.Chtimes(entryPath, now, now); err != nil {
+		d.log.Warn().Err(err).Msgf("error updating last use of %v", entryPath)
+	}
+
+	if err := d.evict(key); err != nil {
+		d.log.Warn().Err(err).Msg("error evicting from download cache")
+	}
+
+	fl, err = os.Open(entryPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening cache entry: %w", err)
+	}
+	return fl, nil
+}
+
+// Prune removes every entry from the cache, returning the number of entries and bytes removed
+func (d *DownloadCache) Prune() (int, int64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entries, err := d.entries()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var freed int64
+	for _, entry := range entries {
+		freed += entry.size
+	}
+
+	if err := os.RemoveAll(d.directory); err != nil {
+		return 0, 0, fmt.Errorf("error removing download cache directory: %w", err)
+	}
+
+	return len(entries), freed, nil
+}
+
+// open opens the entry at path and marks it used, returning nil if it isn't cached
+func (d *DownloadCache) open(path string) (*os.File, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	fl, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening cache entry: %w", err)
+	}
+
+	now := d.nowFunc()
+	if err := os.Chtimes(path, now, now); err != nil {
+		d.log.Warn().Err(err).Msgf("error updating last use of %v", path)
+	}
+
+	return fl, nil
+}
+
+// evict removes least recently used entries until the cache fits within maxBytes, never removing keep. Must be called
+// with mu held
+func (d *DownloadCache) evict(keep string) error {
+	if d.maxBytes <= 0 {
+		return nil
+	}
+
+	entries, err := d.entries()
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, entry := range entries {
+		total += entry.size
+	}
+
+	slices.SortFunc(entries, func(a, b downloadCacheEntry) int {
+		return a.lastUsed.Compare(b.lastUsed)
+	})
+
+	for _, entry := range entries {
+		if total <= d.maxBytes {
+			break
+		}
+		if entry.key == keep {
+			continue
+		}
+
+		d.log.Debug().Msgf("evicting %v from download cache", entry.key)
+		if err := os.RemoveAll(filepath.Join(d.directory, entry.key)); err != nil {
+			return fmt.Errorf("error removing cache entry %v: %w", entry.key, err)
+		}
+		total -= entry.size
+	}
+
+	return nil
+}
+
+func (d *DownloadCache) entries() ([]downloadCacheEntry, error) {
+	dirEntries, err := os.ReadDir(d.directory)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading download cache directory: %w", err)
+	}
+
+	entries := []downloadCacheEntry{}
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() || strings.HasPrefix(dirEntry.Name(), downloadCacheStagingPrefix) {
+			continue
+		}
+
+		entry := downloadCacheEntry{key: dirEntry.Name()}
+		files, err := os.ReadDir(filepath.Join(d.directory, dirEntry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading cache entry %v: %w", dirEntry.Name(), err)
+		}
+		for _, file := range files {
+			info, err := file.Info()
+			if err != nil {
+				return nil, fmt.Errorf("error reading cache entry %v: %w", dirEntry.Name(), err)
+			}
+			entry.size += info.Size()
+			if info.ModTime().After(entry.lastUsed) {
+				entry.lastUsed = info.ModTime()
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
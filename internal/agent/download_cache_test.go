@@ -0,0 +1,193 @@
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadCache(t *testing.T) {
+	const (
+		downloadURL = "http://fake-place.example.com/downloads/some-binary"
+	)
+
+	download := func(t *testing.T, cache *DownloadCache, client *http.Client, url string, digest string) string {
+		t.Helper()
+
+		destDir := t.TempDir()
+		resp, err := DownloadFromUrl(context.Background(), &DownloadRequest{
+			Logger:               zerolog.Nop(),
+			Client:               client,
+			URL:                  url,
+			DestinationDirectory: destDir,
+			Cache:                cache,
+			ExpectedDigest:       digest,
+		})
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(resp.DownloadPath)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	t.Run("hits skip the network", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, httpmock.NewStringResponder(http.StatusOK, "some-content"))
+		client := &http.Client{Transport: mockTransport}
+
+		cache := NewDownloadCache(DownloadCacheConfig{
+			Logger:    zerolog.Nop(),
+			Directory: filepath.Join(t.TempDir(), "cache"),
+		})
+
+		require.Equal(t, "some-content", download(t, cache, client, downloadURL, ""))
+		require.Equal(t, "some-content", download(t, cache, client, downloadURL, ""))
+		require.Equal(t, 1, mockTransport.GetTotalCallCount())
+
+		// A different digest for the same URL is a different entry
+		sum := sha256.Sum256([]byte("some-content"))
+		require.Equal(t, "some-content", download(t, cache, client, downloadURL, "sha256:"+hex.EncodeToString(sum[:])))
+		require.Equal(t, 2, mockTransport.GetTotalCallCount())
+	})
+
+	t.Run("failed downloads are not cached", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, httpmock.NewStringResponder(http.StatusInternalServerError, "kaboom"))
+
+		dir := filepath.Join(t.TempDir(), "cache")
+		cache := NewDownloadCache(DownloadCacheConfig{
+			Logger:    zerolog.Nop(),
+			Directory: dir,
+		})
+
+		_, err := DownloadFromUrl(context.Background(), &DownloadRequest{
+			Logger:               zerolog.Nop(),
+			Client:               &http.Client{Transport: mockTransport},
+			URL:                  downloadURL,
+			DestinationDirectory: t.TempDir(),
+			Cache:                cache,
+		})
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("evicts least recently used past max size", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		for _, name := range []string{"one", "two", "three"} {
+			mockTransport.RegisterResponder(
+				http.MethodGet,
+				"http://fake-place.example.com/downloads/"+name,
+				httpmock.NewStringResponder(http.StatusOK, "0123456789"),
+			)
+		}
+		client := &http.Client{Transport: mockTransport}
+
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		cache := NewDownloadCache(DownloadCacheConfig{
+			Logger:    zerolog.Nop(),
+			Directory: filepath.Join(t.TempDir(), "cache"),
+			MaxBytes:  25,
+			NowFunc: func() time.Time {
+				return now
+			},
+		})
+
+		fetch := func(name string) {
+			now = now.Add(time.Minute)
+			download(t, cache, client, "http://fake-place.example.com/downloads/"+name, "")
+		}
+
+		fetch("one")
+		fetch("two")
+		// Use "one" again, so "two" is now the least recently used
+		fetch("one")
+		fetch("three")
+
+		entries, err := cache.entries()
+		require.NoError(t, err)
+		keys := []string{}
+		for _, entry := range entries {
+			keys = append(keys, entry.key)
+		}
+		require.ElementsMatch(
+			t,
+			[]string{
+				downloadCacheKey("http://fake-place.example.com/downloads/one", ""),
+				downloadCacheKey("http://fake-place.example.com/downloads/three", ""),
+			},
+			keys,
+		)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, httpmock.NewStringResponder(http.StatusOK, "some-content"))
+		client := &http.Client{Transport: mockTransport}
+
+		cache := NewDownloadCache(DownloadCacheConfig{
+			Logger:    zerolog.Nop(),
+			Directory: filepath.Join(t.TempDir(), "cache"),
+		})
+
+		download(t, cache, client, downloadURL, "")
+
+		removed, freed, err := cache.Prune()
+		require.NoError(t, err)
+		require.Equal(t, 1, removed)
+		require.Equal(t, int64(len("some-content")), freed)
+
+		download(t, cache, client, downloadURL, "")
+		require.Equal(t, 2, mockTransport.GetTotalCallCount())
+	})
+
+	t.Run("hits stay readable after a prune", func(t *testing.T) {
+		cache := NewDownloadCache(DownloadCacheConfig{
+			Logger:    zerolog.Nop(),
+			Directory: filepath.Join(t.TempDir(), "cache"),
+		})
+
+		populate := func(path string) error {
+			return os.WriteFile(path, []byte("some-content"), 0644)
+		}
+		fl, err := cache.Fetch(downloadURL, "", "some-binary", populate)
+		require.NoError(t, err)
+		fl.Close()
+
+		fl, err = cache.Fetch(downloadURL, "", "some-binary", func(string) error {
+			t.Fatal("expected a cache hit")
+			return nil
+		})
+		require.NoError(t, err)
+		defer fl.Close()
+
+		_, _, err = cache.Prune()
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(fl)
+		require.NoError(t, err)
+		require.Equal(t, "some-content", string(content))
+
+		// The pruned entry is a miss, not an error
+		calls := 0
+		fl, err = cache.Fetch(downloadURL, "", "some-binary", func(path string) error {
+			calls++
+			return populate(path)
+		})
+		require.NoError(t, err)
+		defer fl.Close()
+		require.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,222 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/carlmjohnson/requests"
+)
+
+type DownloadRetryConfig struct {
+	// MaxAttempts is the total number of attempts made, including the first. Zero or less means a single attempt
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, doubling on each subsequent retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// AttemptTimeout bounds a single attempt, zero means only the callers context applies
+	AttemptTimeout time.Duration
+
+	SleepFunc func(context.Context, time.Duration) error // for unit tests
+}
+
+type downloadStatusError struct {
+	statusCode int
+	retryAfter time.Duration
+}
+
+func (e *downloadStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %v", e.statusCode)
+}
+
+func (e *downloadStatusError) retryable() bool {
+	return e.statusCode == http.StatusTooManyRequests || e.statusCode >= 500
+}
+
+// fetchWithRetry downloads req.URL to path, retrying transient failures with exponential backoff and jitter. If the
+// server advertises range support, retries resume from the bytes already on disk rather than starting over
+func fetchWithRetry(ctx context.Context, req *DownloadRequest, path string) error {
+	conf := req.Retry
+	attempts := max(conf.MaxAttempts, 1)
+	sleep := conf.SleepFunc
+	if sleep == nil {
+		sleep = sleepCtx
+	}
+
+	fl, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0664)
+	if err != nil {
+		return fmt.Errorf("error creating download file: %w", err)
+	}
+	defer fl.Close()
+
+	resumable := false
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			wait := downloadBackoff(conf, attempt-1)
+			var statusErr *downloadStatusError
+			if errors.As(lastErr, &statusErr) && statusErr.retryAfter > 0 {
+				// Honor the server, within reason
+				wait = statusErr.retryAfter
+				if conf.MaxBackoff > 0 {
+					wait = min(wait, conf.MaxBackoff)
+				}
+			}
+			req.Logger.Debug().Err(lastErr).Msgf("download attempt %v of %v failed, retrying in %v", attempt-1, attempts, wait)
+			if err := sleep(ctx, wait); err != nil {
+				return fmt.Errorf("error executing download request: %w", err)
+			}
+		}
+
+		offset := int64(0)
+		if resumable {
+			info, err := fl.Stat()
+			if err != nil {
+				return fmt.Errorf("error reading partial download: %w", err)
+			}
+			offset = info.Size()
+		}
+		if offset == 0 {
+			if err := resetFile(fl); err != nil {
+				return err
+			}
+		}
+
+		var retry bool
+		resumable, retry, lastErr = fetchAttempt(ctx, req, fl, offset, resumable)
+		if lastErr == nil {
+			return nil
+		}
+		if !retry || ctx.Err() != nil {
+			break
+		}
+	}
+
+	return fmt.Errorf("error executing download request: %w", lastErr)
+}
+
+// fetchAttempt makes a single request, appending to fl from offset. It reports if the server supports resuming, carrying
+// forward what was already known if no response was received, and if a failure is worth retrying
+func fetchAttempt(ctx context.Context, req *DownloadRequest, fl *os.File, offset int64, resumable bool) (bool, bool, error) {
+	if req.Retry.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, req.Retry.AttemptTimeout)
+		defer cancel()
+	}
+
+	builder := requests.URL(req.URL)
+	if req.RequestModFunc != nil {
+		builder = req.RequestModFunc(builder)
+	}
+	if offset > 0 {
+		req.Logger.Debug().Msgf("resuming download from byte %v", offset)
+		builder = builder.Header("Range", fmt.Sprintf("bytes=%v-", offset))
+	}
+
+	httpReq, err := builder.Request(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("error building request: %w", err)
+	}
+
+	client := req.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req.Logger.Trace().Msg("executing request")
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return resumable, true, err
+	}
+	defer resp.Body.Close()
+
+	resumable = resp.Header.Get("Accept-Ranges") == "bytes"
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent:
+		// Some servers answer with a range even when we didn't ask for one, which from the start is the whole body
+		if !strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %v-", offset)) {
+			// Not the range we asked for, start over rather than stitching together garbage
+			return false, true, fmt.Errorf("unexpected content range %q", resp.Header.Get("Content-Range"))
+		}
+		resumable = true
+	case resp.StatusCode == http.StatusOK:
+		// Either a fresh download, or the server ignored our range request
+		if err := resetFile(fl); err != nil {
+			return false, false, err
+		}
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		return false, true, &downloadStatusError{statusCode: resp.StatusCode}
+	default:
+		statusErr := &downloadStatusError{
+			statusCode: resp.StatusCode,
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+		return resumable, statusErr.retryable(), statusErr
+	}
+
+	if _, err := io.Copy(fl, resp.Body); err != nil {
+		return resumable, true, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	return resumable, false, nil
+}
+
+func resetFile(fl *os.File) error {
+	if err := fl.Truncate(0); err != nil {
+		return fmt.Errorf("error truncating download file: %w", err)
+	}
+	if _, err := fl.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error seeking download file: %w", err)
+	}
+	return nil
+}
+
+// downloadBackoff returns the wait before the given retry, exponential in the retry number with jitter spreading it
+// between half and all of the computed value
+func downloadBackoff(conf DownloadRetryConfig, retry int) time.Duration {
+	if conf.InitialBackoff <= 0 {
+		return 0
+	}
+
+	wait := conf.InitialBackoff << (retry - 1)
+	if conf.MaxBackoff > 0 && (wait > conf.MaxBackoff || wait <= 0) {
+		wait = conf.MaxBackoff
+	}
+
+	half := wait / 2
+	return half + rand.N(half+1) //nolint:gosec // jitter, not crypto
+}
+
+// parseRetryAfter handles both forms of Retry-After, delay-seconds and an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return max(time.Until(when), 0)
+	}
+	return 0
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,257 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset by peer")
+}
+
+func TestFetchWithRetry(t *testing.T) {
+	const (
+		downloadURL = "http://fake-place.example.com/downloads/some-binary"
+	)
+
+	type sleeps struct {
+		waits []time.Duration
+	}
+
+	newRequest := func(transport http.RoundTripper, retry DownloadRetryConfig) (*DownloadRequest, *sleeps) {
+		s := &sleeps{}
+		retry.SleepFunc = func(_ context.Context, d time.Duration) error {
+			s.waits = append(s.waits, d)
+			return nil
+		}
+		return &DownloadRequest{
+			Logger: zerolog.Nop(),
+			Client: &http.Client{Transport: transport},
+			URL:    downloadURL,
+			Retry:  retry,
+		}, s
+	}
+
+	readFile := func(t *testing.T, path string) string {
+		t.Helper()
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	t.Run("retries server errors with backoff", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			downloadURL,
+			httpmock.NewStringResponder(http.StatusServiceUnavailable, "").
+				Then(httpmock.NewStringResponder(http.StatusBadGateway, "")).
+				Then(httpmock.NewStringResponder(http.StatusOK, "some-content")),
+		)
+
+		req, s := newRequest(mockTransport, DownloadRetryConfig{
+			MaxAttempts:    4,
+			InitialBackoff: time.Second,
+			MaxBackoff:     time.Minute,
+		})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		require.NoError(t, fetchWithRetry(context.Background(), req, path))
+		require.Equal(t, "some-content", readFile(t, path))
+		require.Equal(t, 3, mockTransport.GetTotalCallCount())
+
+		require.Len(t, s.waits, 2)
+		require.GreaterOrEqual(t, s.waits[0], 500*time.Millisecond)
+		require.LessOrEqual(t, s.waits[0], time.Second)
+		require.GreaterOrEqual(t, s.waits[1], time.Second)
+		require.LessOrEqual(t, s.waits[1], 2*time.Second)
+	})
+
+	t.Run("honors retry-after", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			downloadURL,
+			httpmock.NewStringResponder(http.StatusTooManyRequests, "").
+				HeaderSet(http.Header{"Retry-After": []string{"7"}}).
+				Then(httpmock.NewStringResponder(http.StatusOK, "some-content")),
+		)
+
+		req, s := newRequest(mockTransport, DownloadRetryConfig{
+			MaxAttempts:    2,
+			InitialBackoff: time.Second,
+		})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		require.NoError(t, fetchWithRetry(context.Background(), req, path))
+		require.Equal(t, []time.Duration{7 * time.Second}, s.waits)
+	})
+
+	t.Run("caps retry-after", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			downloadURL,
+			httpmock.NewStringResponder(http.StatusServiceUnavailable, "").
+				HeaderSet(http.Header{"Retry-After": []string{"86400"}}).
+				Then(httpmock.NewStringResponder(http.StatusOK, "some-content")),
+		)
+
+		req, s := newRequest(mockTransport, DownloadRetryConfig{
+			MaxAttempts:    2,
+			InitialBackoff: time.Second,
+			MaxBackoff:     30 * time.Second,
+		})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		require.NoError(t, fetchWithRetry(context.Background(), req, path))
+		require.Equal(t, []time.Duration{30 * time.Second}, s.waits)
+	})
+
+	t.Run("accepts partial content from the start", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			downloadURL,
+			httpmock.NewStringResponder(http.StatusPartialContent, "some-content").
+				HeaderSet(http.Header{"Content-Range": []string{"bytes 0-11/12"}}),
+		)
+
+		req, _ := newRequest(mockTransport, DownloadRetryConfig{MaxAttempts: 2})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		require.NoError(t, fetchWithRetry(context.Background(), req, path))
+		require.Equal(t, "some-content", readFile(t, path))
+		require.Equal(t, 1, mockTransport.GetTotalCallCount())
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+		req, s := newRequest(mockTransport, DownloadRetryConfig{MaxAttempts: 4})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		err := fetchWithRetry(context.Background(), req, path)
+		require.ErrorContains(t, err, "unexpected status 404")
+		require.Equal(t, 1, mockTransport.GetTotalCallCount())
+		require.Empty(t, s.waits)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, httpmock.NewStringResponder(http.StatusInternalServerError, ""))
+
+		req, _ := newRequest(mockTransport, DownloadRetryConfig{MaxAttempts: 3})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		err := fetchWithRetry(context.Background(), req, path)
+		require.ErrorContains(t, err, "unexpected status 500")
+		require.Equal(t, 3, mockTransport.GetTotalCallCount())
+	})
+
+	t.Run("resumes partial downloads", func(t *testing.T) {
+		ranges := []string{}
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			downloadURL,
+			func(req *http.Request) (*http.Response, error) {
+				ranges = append(ranges, req.Header.Get("Range"))
+				if req.Header.Get("Range") == "" {
+					resp := httpmock.NewStringResponse(http.StatusOK, "")
+					resp.Header.Set("Accept-Ranges", "bytes")
+					resp.Body = io.NopCloser(io.MultiReader(strings.NewReader("01234"), failingReader{}))
+					return resp, nil
+				}
+
+				resp := httpmock.NewStringResponse(http.StatusPartialContent, "56789")
+				resp.Header.Set("Accept-Ranges", "bytes")
+				resp.Header.Set("Content-Range", "bytes 5-9/10")
+				return resp, nil
+			},
+		)
+
+		req, _ := newRequest(mockTransport, DownloadRetryConfig{MaxAttempts: 2})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		require.NoError(t, fetchWithRetry(context.Background(), req, path))
+		require.Equal(t, "0123456789", readFile(t, path))
+		require.Equal(t, []string{"", "bytes=5-"}, ranges)
+	})
+
+	t.Run("starts over without range support", func(t *testing.T) {
+		calls := 0
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			downloadURL,
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				require.Empty(t, req.Header.Get("Range"))
+				if calls == 1 {
+					resp := httpmock.NewStringResponse(http.StatusOK, "")
+					resp.Body = io.NopCloser(io.MultiReader(strings.NewReader("01234"), failingReader{}))
+					return resp, nil
+				}
+				return httpmock.NewStringResponse(http.StatusOK, "0123456789"), nil
+			},
+		)
+
+		req, _ := newRequest(mockTransport, DownloadRetryConfig{MaxAttempts: 2})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		require.NoError(t, fetchWithRetry(context.Background(), req, path))
+		require.Equal(t, "0123456789", readFile(t, path))
+	})
+
+	t.Run("attempt timeout", func(t *testing.T) {
+		calls := &atomic.Int32{}
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			downloadURL,
+			func(req *http.Request) (*http.Response, error) {
+				if calls.Add(1) == 1 {
+					<-req.Context().Done()
+					return nil, req.Context().Err()
+				}
+				return httpmock.NewStringResponse(http.StatusOK, "some-content"), nil
+			},
+		)
+
+		req, _ := newRequest(mockTransport, DownloadRetryConfig{
+			MaxAttempts:    2,
+			AttemptTimeout: 10 * time.Millisecond,
+		})
+		path := filepath.Join(t.TempDir(), "some-binary")
+
+		require.NoError(t, fetchWithRetry(context.Background(), req, path))
+		require.Equal(t, "some-content", readFile(t, path))
+		require.Equal(t, int32(2), calls.Load())
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	require.Equal(t, time.Duration(0), parseRetryAfter(""))
+	require.Equal(t, 30*time.Second, parseRetryAfter("30"))
+	require.Equal(t, time.Duration(0), parseRetryAfter("garbage"))
+
+	got := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	require.Greater(t, got, 50*time.Second)
+	require.LessOrEqual(t, got, time.Minute)
+}
@@ -0,0 +1,212 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/carlmjohnson/requests"
+	"github.com/jarcoal/httpmock"
+	"github.com/nicjohnson145/plantr/internal/signature"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadFromUrlChecksum(t *testing.T) {
+	const (
+		downloadURL = "http://fake-place.example.com/downloads/some-binary"
+		content     = "some-binary-content"
+	)
+
+	sum := sha256.Sum256([]byte(content))
+	goodDigest := "sha256:" + hex.EncodeToString(sum[:])
+	badDigest := "sha256:" + hex.EncodeToString(make([]byte, 32))
+
+	download := func(t *testing.T, cache *DownloadCache, digest string) (string, error) {
+		t.Helper()
+
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, httpmock.NewStringResponder(http.StatusOK, content))
+
+		destDir := t.TempDir()
+		_, err := DownloadFromUrl(context.Background(), &DownloadRequest{
+			Logger:               zerolog.Nop(),
+			Client:               &http.Client{Transport: mockTransport},
+			URL:                  downloadURL,
+			DestinationDirectory: destDir,
+			ExpectedDigest:       digest,
+			Cache:                cache,
+		})
+		return destDir, err
+	}
+
+	t.Run("match", func(t *testing.T) {
+		destDir, err := download(t, nil, goodDigest)
+		require.NoError(t, err)
+
+		got, err := os.ReadFile(filepath.Join(destDir, "some-binary"))
+		require.NoError(t, err)
+		require.Equal(t, content, string(got))
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		destDir, err := download(t, nil, badDigest)
+		require.ErrorIs(t, err, ErrChecksumMismatchError)
+
+		_, err = os.Stat(filepath.Join(destDir, "some-binary"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("mismatch is not cached", func(t *testing.T) {
+		cache := NewDownloadCache(DownloadCacheConfig{
+			Logger:    zerolog.Nop(),
+			Directory: filepath.Join(t.TempDir(), "cache"),
+		})
+
+		_, err := download(t, cache, badDigest)
+		require.ErrorIs(t, err, ErrChecksumMismatchError)
+
+		entries, err := cache.entries()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("unsupported digest", func(t *testing.T) {
+		_, err := download(t, nil, "md5:abc")
+		require.ErrorContains(t, err, "unsupported digest")
+	})
+}
+
+func TestDownloadFromUrlSignature(t *testing.T) {
+	const (
+		downloadURL  = "http://fake-place.example.com/downloads/some-binary"
+		signatureURL = "http://fake-place.example.com/downloads/some-binary.asc"
+		content      = "some-binary-content"
+	)
+
+	newKey := func(t *testing.T) (*openpgp.Entity, string) {
+		t.Helper()
+
+		entity, err := openpgp.NewEntity("Some User", "", "some-user@example.com", nil)
+		require.NoError(t, err)
+
+		buf := &bytes.Buffer{}
+		w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+		require.NoError(t, err)
+		require.NoError(t, entity.Serialize(w))
+		require.NoError(t, w.Close())
+
+		return entity, buf.String()
+	}
+
+	entity, public := newKey(t)
+	otherEntity, _ := newKey(t)
+
+	download := func(t *testing.T, cache *DownloadCache, signer *openpgp.Entity) (string, *httpmock.MockTransport, error) {
+		t.Helper()
+
+		sig := &bytes.Buffer{}
+		require.NoError(t, openpgp.ArmoredDetachSign(sig, signer, strings.NewReader(content), nil))
+
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, httpmock.NewStringResponder(http.StatusOK, content))
+		mockTransport.RegisterResponder(http.MethodGet, signatureURL, httpmock.NewStringResponder(http.StatusOK, sig.String()))
+
+		destDir := t.TempDir()
+		_, err := DownloadFromUrl(context.Background(), &DownloadRequest{
+			Logger:               zerolog.Nop(),
+			Client:               &http.Client{Transport: mockTransport},
+			URL:                  downloadURL,
+			DestinationDirectory: destDir,
+			Signature: &DownloadSignature{
+				Kind:      signature.KindOpenPGP,
+				PublicKey: public,
+				URL:       signatureURL,
+			},
+			Cache: cache,
+		})
+		return destDir, mockTransport, err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		destDir, mockTransport, err := download(t, nil, entity)
+		require.NoError(t, err)
+		require.Equal(t, 1, mockTransport.GetCallCountInfo()["GET "+signatureURL])
+
+		got, err := os.ReadFile(filepath.Join(destDir, "some-binary"))
+		require.NoError(t, err)
+		require.Equal(t, content, string(got))
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		destDir, _, err := download(t, nil, otherEntity)
+		require.ErrorIs(t, err, signature.ErrVerificationFailedError)
+
+		_, err = os.Stat(filepath.Join(destDir, "some-binary"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("failure is not cached", func(t *testing.T) {
+		cache := NewDownloadCache(DownloadCacheConfig{
+			Logger:    zerolog.Nop(),
+			Directory: filepath.Join(t.TempDir(), "cache"),
+		})
+
+		_, _, err := download(t, cache, otherEntity)
+		require.ErrorIs(t, err, signature.ErrVerificationFailedError)
+
+		entries, err := cache.entries()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("credentials stay on the download host", func(t *testing.T) {
+		const otherSignatureURL = "http://other-place.example.com/signatures/some-binary.asc"
+
+		sig := &bytes.Buffer{}
+		require.NoError(t, openpgp.ArmoredDetachSign(sig, entity, strings.NewReader(content), nil))
+
+		auth := map[string]string{}
+		respond := func(body string) httpmock.Responder {
+			return func(req *http.Request) (*http.Response, error) {
+				auth[req.URL.String()] = req.Header.Get("Authorization")
+				return httpmock.NewStringResponse(http.StatusOK, body), nil
+			}
+		}
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(http.MethodGet, downloadURL, respond(content))
+		mockTransport.RegisterResponder(http.MethodGet, otherSignatureURL, respond(sig.String()))
+
+		_, err := DownloadFromUrl(context.Background(), &DownloadRequest{
+			Logger: zerolog.Nop(),
+			Client: &http.Client{Transport: mockTransport},
+			URL:    downloadURL,
+			RequestModFunc: func(builder *requests.Builder) *requests.Builder {
+				return builder.Bearer("some-token")
+			},
+			DestinationDirectory: t.TempDir(),
+			Signature: &DownloadSignature{
+				Kind:      signature.KindOpenPGP,
+				PublicKey: public,
+				URL:       otherSignatureURL,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[string]string{
+				downloadURL:       "Bearer some-token",
+				otherSignatureURL: "",
+			},
+			auth,
+		)
+	})
+}
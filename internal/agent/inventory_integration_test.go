@@ -46,6 +46,22 @@ func TestInventoryIntegration(t *testing.T) {
 			},
 			row,
 		)
+
+		// List hashes
+		hashes, err := store.ListHashes(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{hash}, hashes)
+
+		// Delete the row
+		require.NoError(t, store.DeleteRows(ctx, []string{hash}))
+
+		row, err = store.GetRow(ctx, hash)
+		require.NoError(t, err)
+		require.Nil(t, row)
+
+		hashes, err = store.ListHashes(ctx)
+		require.NoError(t, err)
+		require.Empty(t, hashes)
 	}
 
 	t.Run("sqlite", func(t *testing.T) {
@@ -14,6 +14,8 @@ import (
 type InventoryClient interface {
 	GetRow(ctx context.Context, hash string) (*InventoryRow, error)
 	WriteRow(ctx context.Context, row InventoryRow) error
+	ListHashes(ctx context.Context) ([]string, error)
+	DeleteRows(ctx context.Context, hashes []string) error
 }
 
 func NewInventoryClientFromEnv(logger zerolog.Logger) (InventoryClient, func(), error) {
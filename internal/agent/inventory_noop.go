@@ -27,3 +27,11 @@ func (n *NoopInventory) GetRow(ctx context.Context, hash string) (*InventoryRow,
 func (n *NoopInventory) WriteRow(ctx context.Context, row InventoryRow) error {
 	return nil
 }
+
+func (n *NoopInventory) ListHashes(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (n *NoopInventory) DeleteRows(ctx context.Context, hashes []string) error {
+	return nil
+}
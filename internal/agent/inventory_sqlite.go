@@ -106,6 +106,36 @@ func (s *SqlLiteInventory) WriteRow(ctx context.Context, row InventoryRow) error
 	})
 }
 
+func (s *SqlLiteInventory) ListHashes(ctx context.Context) ([]string, error) {
+	stmt := `
+		SELECT
+			hash
+		FROM
+			agent_inventory
+		ORDER BY
+			hash
+	`
+
+	hashes := []string{}
+	if err := s.db.SelectContext(ctx, &hashes, stmt); err != nil {
+		return nil, fmt.Errorf("error selecting: %w", err)
+	}
+
+	return hashes, nil
+}
+
+func (s *SqlLiteInventory) DeleteRows(ctx context.Context, hashes []string) error {
+	return hsqlx.WithTransaction(s.db, func(txn *sqlx.Tx) error {
+		for _, hash := range hashes {
+			if err := s.purgeByColumn(ctx, txn, "hash", hash); err != nil {
+				return fmt.Errorf("error purging %v: %w", hash, err)
+			}
+		}
+
+		return nil
+	})
+}
+
 func (s *SqlLiteInventory) purgeByPath(ctx context.Context, txn *sqlx.Tx, path string) error {
 	return s.purgeByColumn(ctx, txn, "path", path)
 }
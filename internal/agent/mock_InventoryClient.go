@@ -21,6 +21,53 @@ func (_m *MockInventoryClient) EXPECT() *MockInventoryClient_Expecter {
 	return &MockInventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// DeleteRows provides a mock function with given fields: ctx, hashes
+func (_m *MockInventoryClient) DeleteRows(ctx context.Context, hashes []string) error {
+	ret := _m.Called(ctx, hashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRows")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, hashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInventoryClient_DeleteRows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRows'
+type MockInventoryClient_DeleteRows_Call struct {
+	*mock.Call
+}
+
+// DeleteRows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashes []string
+func (_e *MockInventoryClient_Expecter) DeleteRows(ctx interface{}, hashes interface{}) *MockInventoryClient_DeleteRows_Call {
+	return &MockInventoryClient_DeleteRows_Call{Call: _e.mock.On("DeleteRows", ctx, hashes)}
+}
+
+func (_c *MockInventoryClient_DeleteRows_Call) Run(run func(ctx context.Context, hashes []string)) *MockInventoryClient_DeleteRows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_DeleteRows_Call) Return(_a0 error) *MockInventoryClient_DeleteRows_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInventoryClient_DeleteRows_Call) RunAndReturn(run func(context.Context, []string) error) *MockInventoryClient_DeleteRows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRow provides a mock function with given fields: ctx, hash
 func (_m *MockInventoryClient) GetRow(ctx context.Context, hash string) (*InventoryRow, error) {
 	ret := _m.Called(ctx, hash)
@@ -80,6 +127,64 @@ func (_c *MockInventoryClient_GetRow_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ListHashes provides a mock function with given fields: ctx
+func (_m *MockInventoryClient) ListHashes(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHashes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInventoryClient_ListHashes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHashes'
+type MockInventoryClient_ListHashes_Call struct {
+	*mock.Call
+}
+
+// ListHashes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInventoryClient_Expecter) ListHashes(ctx interface{}) *MockInventoryClient_ListHashes_Call {
+	return &MockInventoryClient_ListHashes_Call{Call: _e.mock.On("ListHashes", ctx)}
+}
+
+func (_c *MockInventoryClient_ListHashes_Call) Run(run func(ctx context.Context)) *MockInventoryClient_ListHashes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_ListHashes_Call) Return(_a0 []string, _a1 error) *MockInventoryClient_ListHashes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInventoryClient_ListHashes_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockInventoryClient_ListHashes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WriteRow provides a mock function with given fields: ctx, row
 func (_m *MockInventoryClient) WriteRow(ctx context.Context, row InventoryRow) error {
 	ret := _m.Called(ctx, row)
@@ -49,3 +49,16 @@ func (s *Service) Sync(ctx context.Context, req *connect.Request[pbv1.SyncReques
 	}
 	return connect.NewResponse(resp), nil
 }
+
+func (s *Service) SyncStream(ctx context.Context, req *connect.Request[pbv1.SyncStreamRequest], stream *connect.ServerStream[pbv1.SyncEvent]) error {
+	_, err := s.agent.SyncWithEvents(ctx, &pbv1.SyncRequest{}, func(event *pbv1.SyncEvent) {
+		if err := stream.Send(event); err != nil {
+			// Client has likely gone away, but the sync should still run to completion
+			s.log.Warn().Err(err).Msg("error sending sync event")
+		}
+	})
+	if err != nil {
+		return s.logAndHandleError(err, "error syncing")
+	}
+	return nil
+}
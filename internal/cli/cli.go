@@ -3,29 +3,57 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt"
 	agentv1 "github.com/nicjohnson145/plantr/gen/plantr/agent/v1"
+	"github.com/nicjohnson145/plantr/gen/plantr/agent/v1/agentv1connect"
+	controllerv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/gen/plantr/controller/v1/controllerv1connect"
 	"github.com/nicjohnson145/plantr/internal/agent"
 	"github.com/nicjohnson145/plantr/internal/encryption"
+	"github.com/nicjohnson145/plantr/internal/token"
 	"github.com/rs/zerolog"
 )
 
 type CLIConfig struct {
-	Logger     zerolog.Logger
-	Agent      *agent.Agent
+	Logger        zerolog.Logger
+	Agent         *agent.Agent
+	AgentClient   agentv1connect.AgentServiceClient
+	Controller    controllerv1connect.ControllerServiceClient
+	DownloadCache *agent.DownloadCache
+	Out           io.Writer
 }
 
 func NewCLI(conf CLIConfig) *CLI {
-	return &CLI{
-		log:   conf.Logger,
-		agent: conf.Agent,
+	c := &CLI{
+		log:           conf.Logger,
+		agent:         conf.Agent,
+		agentClient:   conf.AgentClient,
+		controller:    conf.Controller,
+		downloadCache: conf.DownloadCache,
+		out:           conf.Out,
 	}
+
+	if c.out == nil {
+		c.out = os.Stdout
+	}
+
+	return c
 }
 
 type CLI struct {
-	log        zerolog.Logger
-	agent      *agent.Agent
+	log           zerolog.Logger
+	agent         *agent.Agent
+	agentClient   agentv1connect.AgentServiceClient
+	controller    controllerv1connect.ControllerServiceClient
+	downloadCache *agent.DownloadCache
+	out           io.Writer
 }
 
 func (c *CLI) GenerateKeyPair() error {
@@ -48,14 +76,362 @@ func (c *CLI) GenerateKeyPair() error {
 	return nil
 }
 
+// GenerateAdminToken signs a token granting the admin role, for use when the controller has no admin api key
+func (c *CLI) GenerateAdminToken(signingKey []byte, duration time.Duration) error {
+	if len(signingKey) == 0 {
+		return fmt.Errorf("must provide the controller's JWT signing key")
+	}
+
+	tokenStr, err := token.GenerateJWT(signingKey, token.Token{
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(duration).Unix(),
+		},
+		Role: token.RoleAdmin,
+	})
+	if err != nil {
+		return fmt.Errorf("error generating token: %w", err)
+	}
+
+	fmt.Fprintln(c.out, tokenStr)
+	return nil
+}
+
 func (c *CLI) Sync() error {
-	_, err := c.agent.Sync(context.Background(), &agentv1.SyncRequest{})
+	_, err := c.agent.SyncWithEvents(context.Background(), &agentv1.SyncRequest{}, newProgressRenderer(c.out).Render)
 	if err != nil {
 		return fmt.Errorf("error syncing:\n%w", err)
 	}
 	return nil
 }
 
+// SyncRemote triggers a sync on a running agent, rendering its progress as it streams back
+func (c *CLI) SyncRemote() error {
+	stream, err := c.agentClient.SyncStream(context.Background(), connect.NewRequest(&agentv1.SyncStreamRequest{}))
+	if err != nil {
+		return fmt.Errorf("error starting sync: %w", err)
+	}
+	defer stream.Close()
+
+	renderer := newProgressRenderer(c.out)
+	for stream.Receive() {
+		renderer.Render(stream.Msg())
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("error syncing:\n%w", err)
+	}
+
+	return nil
+}
+
+func (c *CLI) CachePrune() error {
+	if c.downloadCache == nil {
+		fmt.Fprintln(c.out, "download cache is disabled, nothing to prune")
+		return nil
+	}
+
+	entries, freed, err := c.downloadCache.Prune()
+	if err != nil {
+		return fmt.Errorf("error pruning download cache: %w", err)
+	}
+
+	fmt.Fprintf(c.out, "removed %v cached download(s), freed %.1f MiB\n", entries, float64(freed)/(1024*1024))
+	return nil
+}
+
 func (c *CLI) ForceRefresh() error {
-	return c.agent.ForceRefresh(context.Background())
+	resp, err := c.controller.ForceRefresh(context.Background(), connect.NewRequest(&controllerv1.ForceRefreshRequest{}))
+	if err != nil {
+		return fmt.Errorf("error forcing refresh: %w", err)
+	}
+
+	return c.printPushResults(resp.Msg.PushResults)
+}
+
+func (c *CLI) Promote(from string, to string) error {
+	resp, err := c.controller.PromoteChannel(context.Background(), connect.NewRequest(&controllerv1.PromoteChannelRequest{
+		From: from,
+		To:   to,
+	}))
+	if err != nil {
+		return fmt.Errorf("error promoting: %w", err)
+	}
+
+	fmt.Fprintf(c.out, "%v now serving %v\n", to, resp.Msg.Commit)
+
+	return c.printPushResults(resp.Msg.PushResults)
+}
+
+func (c *CLI) ResetChannel(channel string) error {
+	resp, err := c.controller.ResetChannel(context.Background(), connect.NewRequest(&controllerv1.ResetChannelRequest{
+		Channel: channel,
+	}))
+	if err != nil {
+		return fmt.Errorf("error resetting channel: %w", err)
+	}
+
+	fmt.Fprintf(c.out, "%v reset, now serving %v\n", channel, resp.Msg.Commit)
+
+	return c.printPushResults(resp.Msg.PushResults)
+}
+
+func (c *CLI) RevisionsList(limit int32) error {
+	resp, err := c.controller.ListConfigRevisions(context.Background(), connect.NewRequest(&controllerv1.ListConfigRevisionsRequest{
+		Limit: limit,
+	}))
+	if err != nil {
+		return fmt.Errorf("error listing revisions: %w", err)
+	}
+
+	if resp.Msg.PinnedCommit != "" {
+		fmt.Fprintf(c.out, "Pinned to %v\n\n", resp.Msg.PinnedCommit)
+	}
+	if poll := resp.Msg.LastPoll; poll != nil {
+		fmt.Fprintf(c.out, "Last polled %v: %v\n\n", poll.PolledAt.AsTime().Local().Format(time.RFC3339), formatPoll(poll))
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "COMMIT\tLOADED\tTRIGGER\tCURRENT")
+	for _, revision := range resp.Msg.Revisions {
+		current := ""
+		if revision.Commit == resp.Msg.CurrentCommit {
+			current = "*"
+		}
+		fmt.Fprintf(
+			w,
+			"%v\t%v\t%v\t%v\n",
+			shortCommit(revision.Commit),
+			revision.LoadedAt.AsTime().Local().Format(time.RFC3339),
+			formatConfigTrigger(revision.Trigger),
+			current,
+		)
+	}
+
+	return w.Flush()
+}
+
+func (c *CLI) RevisionsPin(commit string) error {
+	resp, err := c.controller.PinConfig(context.Background(), connect.NewRequest(&controllerv1.PinConfigRequest{
+		Commit: commit,
+	}))
+	if err != nil {
+		return fmt.Errorf("error pinning: %w", err)
+	}
+
+	fmt.Fprintf(c.out, "pinned to %v\n", resp.Msg.Commit)
+
+	return c.printPushResults(resp.Msg.PushResults)
+}
+
+func (c *CLI) RevisionsUnpin() error {
+	resp, err := c.controller.UnpinConfig(context.Background(), connect.NewRequest(&controllerv1.UnpinConfigRequest{}))
+	if err != nil {
+		return fmt.Errorf("error unpinning: %w", err)
+	}
+
+	fmt.Fprintf(c.out, "unpinned, now serving %v\n", resp.Msg.Commit)
+
+	return c.printPushResults(resp.Msg.PushResults)
+}
+
+// printPushResults prints the outcome of a push sync, returning an error if any node failed
+func (c *CLI) printPushResults(results []*controllerv1.PushSyncResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	failed := 0
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tRESULT\tERROR")
+	for _, result := range results {
+		if result.Result != controllerv1.SyncResult_SYNC_RESULT_SUCCESS {
+			failed++
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\n", result.NodeId, formatSyncResult(result.Result), result.Error)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("error writing output: %w", err)
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("%v node(s) failed to sync", failed)
+	}
+
+	return nil
+}
+
+func (c *CLI) FleetList() error {
+	resp, err := c.controller.ListNodes(context.Background(), connect.NewRequest(&controllerv1.ListNodesRequest{}))
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tHOSTNAME\tCHANNEL\tLAST SEEN\tRESULT\tCOMMIT\tFAILING")
+	for _, node := range resp.Msg.Nodes {
+		fmt.Fprintf(
+			w,
+			"%v\t%v\t%v\t%v\t%v\t%v\t%v\n",
+			node.NodeId,
+			node.Hostname,
+			formatChannel(node.Channel),
+			formatLastSeen(node),
+			formatSyncResult(node.LastSyncResult),
+			shortCommit(node.AppliedCommit),
+			len(node.FailingSeeds),
+		)
+	}
+
+	return w.Flush()
+}
+
+func (c *CLI) FleetShow(nodeID string) error {
+	resp, err := c.controller.GetNodeStatus(context.Background(), connect.NewRequest(&controllerv1.GetNodeStatusRequest{
+		NodeId: nodeID,
+	}))
+	if err != nil {
+		return fmt.Errorf("error getting node status: %w", err)
+	}
+	node := resp.Msg.Node
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Node:\t%v\n", node.NodeId)
+	fmt.Fprintf(w, "Hostname:\t%v\n", node.Hostname)
+	fmt.Fprintf(w, "Last Seen:\t%v\n", formatLastSeen(node))
+	fmt.Fprintf(w, "Last Sync:\t%v\n", formatSyncResult(node.LastSyncResult))
+	fmt.Fprintf(w, "Applied Commit:\t%v\n", node.AppliedCommit)
+	fmt.Fprintf(w, "Channel:\t%v\n", formatChannel(node.Channel))
+	fmt.Fprintf(w, "Channel Commit:\t%v\n", node.ChannelCommit)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(node.FailingSeeds) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(c.out, "\nFailing Seeds:")
+	for _, seed := range node.FailingSeeds {
+		fmt.Fprintf(c.out, "  %v\n    %v\n", seed.DisplayName, seed.Error)
+	}
+
+	return nil
+}
+
+// Outdated prints each pinned seed whose latest upstream version is newer than the pin, or that couldn't be checked.
+// With all, up to date seeds are included too
+func (c *CLI) Outdated(all bool) error {
+	resp, err := c.controller.ListOutdated(context.Background(), connect.NewRequest(&controllerv1.ListOutdatedRequest{}))
+	if err != nil {
+		return fmt.Errorf("error listing outdated seeds: %w", err)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tCURRENT\tLATEST\tROLES")
+	for _, seed := range resp.Msg.Seeds {
+		if !all && !seed.Outdated && seed.Error == "" {
+			continue
+		}
+
+		latest := seed.Latest
+		if seed.Error != "" {
+			latest = "error: " + seed.Error
+		}
+		fmt.Fprintf(
+			w,
+			"%v\t%v\t%v\t%v\t%v\n",
+			seed.Kind,
+			seed.Name,
+			seed.Current,
+			latest,
+			strings.Join(seed.Roles, ","),
+		)
+	}
+
+	return w.Flush()
+}
+
+func (c *CLI) RateLimits() error {
+	resp, err := c.controller.GetGithubRateLimits(context.Background(), connect.NewRequest(&controllerv1.GetGithubRateLimitsRequest{}))
+	if err != nil {
+		return fmt.Errorf("error getting rate limits: %w", err)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HOST\tRESOURCE\tREMAINING\tLIMIT\tRESETS\tUPDATED")
+	for _, limit := range resp.Msg.RateLimits {
+		fmt.Fprintf(
+			w,
+			"%v\t%v\t%v\t%v\t%v\t%v\n",
+			limit.Host,
+			limit.Resource,
+			limit.Remaining,
+			limit.Limit,
+			limit.ResetsAt.AsTime().Local().Format(time.RFC3339),
+			limit.UpdatedAt.AsTime().Local().Format(time.RFC3339),
+		)
+	}
+
+	return w.Flush()
+}
+
+func formatLastSeen(node *controllerv1.NodeStatus) string {
+	if node.LastSeen == nil {
+		return "never"
+	}
+	return node.LastSeen.AsTime().Local().Format(time.RFC3339)
+}
+
+func formatChannel(channel string) string {
+	if channel == "" {
+		return "default"
+	}
+	return channel
+}
+
+func formatConfigTrigger(trigger controllerv1.ConfigTrigger) string {
+	switch trigger {
+	case controllerv1.ConfigTrigger_CONFIG_TRIGGER_STARTUP:
+		return "startup"
+	case controllerv1.ConfigTrigger_CONFIG_TRIGGER_WEBHOOK:
+		return "webhook"
+	case controllerv1.ConfigTrigger_CONFIG_TRIGGER_FORCE_REFRESH:
+		return "force-refresh"
+	case controllerv1.ConfigTrigger_CONFIG_TRIGGER_PIN:
+		return "pin"
+	case controllerv1.ConfigTrigger_CONFIG_TRIGGER_UNPIN:
+		return "unpin"
+	case controllerv1.ConfigTrigger_CONFIG_TRIGGER_POLL:
+		return "poll"
+	default:
+		return "-"
+	}
+}
+
+func formatPoll(poll *controllerv1.ConfigPoll) string {
+	switch {
+	case poll.Error != "":
+		return "error: " + poll.Error
+	case poll.Changed:
+		return "loaded " + shortCommit(poll.Commit)
+	default:
+		return "no change"
+	}
+}
+
+func formatSyncResult(result controllerv1.SyncResult) string {
+	switch result {
+	case controllerv1.SyncResult_SYNC_RESULT_SUCCESS:
+		return "success"
+	case controllerv1.SyncResult_SYNC_RESULT_FAILURE:
+		return "failure"
+	default:
+		return "-"
+	}
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
 }
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/nicjohnson145/plantr/gen/plantr/agent/v1/agentv1connect"
+	controllerv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/gen/plantr/controller/v1/controllerv1connect"
+	"github.com/nicjohnson145/plantr/internal/agent"
+	"github.com/nicjohnson145/plantr/internal/interceptors"
+	"github.com/spf13/viper"
+)
+
+func NewAdminClientFromEnv() (controllerv1connect.ControllerServiceClient, error) {
+	controllerAddress := viper.GetString(agent.ControllerAddress)
+	if controllerAddress == "" {
+		return nil, errors.New("controller address must be set")
+	}
+
+	apiKey := viper.GetString(AdminAPIKey)
+	if apiKey == "" {
+		return nil, errors.New("admin api key must be set")
+	}
+
+	return controllerv1connect.NewControllerServiceClient(
+		http.DefaultClient,
+		controllerAddress,
+		connect.WithInterceptors(interceptors.NewClientAuthInterceptor(apiKey)),
+	), nil
+}
+
+// NewAgentClientFromEnv creates a client for the agent at address, authenticating with a short lived token the
+// controller issues for this node. The CLI never holds the agent auth secret or private key
+func NewAgentClientFromEnv(address string) (agentv1connect.AgentServiceClient, error) {
+	nodeID := viper.GetString(agent.NodeID)
+	if nodeID == "" {
+		return nil, errors.New("node id must be set")
+	}
+
+	controller, err := NewAdminClientFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := controller.IssueAgentToken(context.Background(), connect.NewRequest(&controllerv1.IssueAgentTokenRequest{
+		NodeId: nodeID,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("error requesting agent token: %w", err)
+	}
+
+	return agentv1connect.NewAgentServiceClient(
+		http.DefaultClient,
+		address,
+		connect.WithInterceptors(interceptors.NewClientAuthInterceptor(resp.Msg.Token)),
+	), nil
+}
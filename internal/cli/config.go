@@ -15,6 +15,10 @@ import (
 const (
 	LoggingLevel  = "log.level"
 	LoggingFormat = "log.format"
+
+	AdminAPIKey = "admin.api_key" //nolint:gosec // its env config, relax
+
+	JWTSigningKey = "jwt.signing_key" //nolint:gosec // its env config, relax
 )
 
 var (
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	agentv1 "github.com/nicjohnson145/plantr/gen/plantr/agent/v1"
+)
+
+// progressRenderer displays sync events as they arrive
+type progressRenderer interface {
+	Render(event *agentv1.SyncEvent)
+}
+
+func newProgressRenderer(out io.Writer) progressRenderer {
+	if f, ok := out.(*os.File); ok && (isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())) {
+		return &ttyProgress{out: out}
+	}
+	return &plainProgress{out: out}
+}
+
+// plainProgress writes one line per event, suitable for logs and pipes
+type plainProgress struct {
+	out   io.Writer
+	total int32
+}
+
+func (p *plainProgress) Render(event *agentv1.SyncEvent) {
+	switch event.Kind {
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_STARTED:
+		p.total = event.Total
+		fmt.Fprintln(p.out, event.Message)
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_FINISHED:
+		fmt.Fprintln(p.out, event.Message)
+	default:
+		line := fmt.Sprintf("[%v/%v] %v %v: %v", event.Index, p.total, eventLabel(event.Kind), event.DisplayName, event.Message)
+		if event.Error != "" {
+			line += ": " + event.Error
+		}
+		fmt.Fprintln(p.out, line)
+	}
+}
+
+// ttyProgress keeps a status line for the seed currently being worked on and prints a permanent line as each seed
+// finishes
+type ttyProgress struct {
+	out   io.Writer
+	total int32
+}
+
+const clearLine = "\r\033[K"
+
+func (t *ttyProgress) Render(event *agentv1.SyncEvent) {
+	switch event.Kind {
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_STARTED:
+		t.total = event.Total
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SEED_STARTED:
+		fmt.Fprintf(t.out, "%v[%v/%v] %v", clearLine, event.Index, t.total, event.Message)
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_FINISHED:
+		fmt.Fprintf(t.out, "%v%v\n", clearLine, event.Message)
+	default:
+		line := fmt.Sprintf("%v[%v/%v] %v %v", clearLine, event.Index, t.total, eventLabel(event.Kind), event.DisplayName)
+		if event.Error != "" {
+			line += ": " + event.Error
+		}
+		fmt.Fprintln(t.out, line)
+	}
+}
+
+func eventLabel(kind agentv1.SyncEventKind) string {
+	switch kind {
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SEED_STARTED:
+		return "started"
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SEED_SKIPPED:
+		return "skipped"
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SEED_SUCCEEDED:
+		return "done"
+	case agentv1.SyncEventKind_SYNC_EVENT_KIND_SEED_FAILED:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
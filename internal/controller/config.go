@@ -20,6 +20,7 @@ type StorageKind string
 ENUM(
 github
 static
+git
 )
 */
 type GitKind string
@@ -39,14 +40,51 @@ const (
 	GitType        = "git.type"
 	GitAccessToken = "git.access_token"
 	GitUrl         = "git.url"
+	GitUsername    = "git.username"
+	GitBranch      = "git.branch"
+	GitTagPattern  = "git.tag_pattern"
+	GitChannels    = "git.channels"
+
+	GitPollInterval = "git.poll_interval"
+	GitPath         = "git.path"
+
+	GitSSHPrivateKeyPath       = "git.ssh.private_key.path"
+	GitSSHPrivateKeyPassphrase = "git.ssh.private_key.passphrase" //nolint:gosec // its env config, relax
+	GitSSHKnownHostsPath       = "git.ssh.known_hosts_path"
 
 	GithubWebhookSecret = "github.webhook_secret" //nolint:gosec // its env config, relax
+	GithubReleaseTagTTL = "github.release_tag_ttl"
+	GithubApiUrl        = "github.api_url"
+	GithubHostname      = "github.hostname"
+	// Deprecated: use ReleaseHosts
+	GithubHosts = "github.hosts"
+
+	ReleaseHosts = "release.hosts"
+
+	GithubRateLimitMaxWait = "github.rate_limit.max_wait"
+
+	ReleaseLookupTimeout = "release.lookup_timeout"
+
+	GithubAppID             = "github.app.id"
+	GithubAppInstallationID = "github.app.installation_id"
+	GithubAppPrivateKeyPath = "github.app.private_key.path" //nolint:gosec // its env config, relax
 
 	GitStaticCheckoutPath = "git.static.checkout_path"
 
 	JWTSigningKey = "jwt.signing_key"
 	JWTDuration   = "jwt.duration"
 
+	AdminAPIKey = "admin.api_key" //nolint:gosec // its env config, relax
+
+	AgentAuthSecret         = "agent_auth.secret"           //nolint:gosec // its env config, relax
+	AgentAuthPrivateKeyPath = "agent_auth.private_key.path" //nolint:gosec // its env config, relax
+
+	PushSyncEnabled     = "push_sync.enabled"
+	PushSyncConcurrency = "push_sync.concurrency"
+	PushSyncTimeout     = "push_sync.timeout"
+
+	RenderConcurrency = "render.concurrency"
+
 	VaultEnabled             = "vault.enabled"
 	VaultHashicorpAddress    = "vault.hashicorp.address"
 	VaultHashicorpUsername   = "vault.hashicorp.username" //nolint:gosec // its env config, relax
@@ -67,15 +105,79 @@ var (
 
 	DefaultSqliteDBPath = "/var/plantr/controller/storage.db"
 
-	DefaultGitType = GitKindGithub.String()
+	DefaultGitType     = GitKindGithub.String()
+	DefaultGitUsername = "git"
+
+	DefaultGitPollInterval = "0s"
 
 	DefaultJWTDuration = "240h" // 10 days
 
 	DefaultAgentPollInterval = "60s"
 
 	DefaultVaultEnabled = false
+
+	DefaultPushSyncEnabled     = false
+	DefaultPushSyncConcurrency = 5
+	DefaultPushSyncTimeout     = "10m"
+
+	DefaultRenderConcurrency = 8
+
+	DefaultGithubReleaseTagTTL = "15m"
+	DefaultGithubApiUrl        = "https://api.github.com"
+	DefaultGithubHostname      = "github.com"
+	DefaultGitlabApiUrl        = "https://gitlab.com/api/v4"
+	DefaultGiteaApiUrl         = "https://gitea.com/api/v1"
+
+	DefaultGithubRateLimitMaxWait = "0s"
+
+	DefaultReleaseLookupTimeout = "30s"
+)
+
+// ReleaseHostApiUrlKey is the API base url of a named release host
+func ReleaseHostApiUrlKey(name string) string {
+	return releaseHostKey(releaseHostPrefix, name, "api_url")
+}
+
+// ReleaseHostTokenKey is the access token of a named release host
+func ReleaseHostTokenKey(name string) string {
+	return releaseHostKey(releaseHostPrefix, name, "token")
+}
+
+// ReleaseHostAppIDKey is the id of the GitHub App a named release host authenticates as
+func ReleaseHostAppIDKey(name string) string {
+	return releaseHostKey(releaseHostPrefix, name, "app.id")
+}
+
+// ReleaseHostAppInstallationIDKey is the installation of the GitHub App on a named release host
+func ReleaseHostAppInstallationIDKey(name string) string {
+	return releaseHostKey(releaseHostPrefix, name, "app.installation_id")
+}
+
+// ReleaseHostAppPrivateKeyPathKey is the path to the GitHub App private key for a named release host
+func ReleaseHostAppPrivateKeyPathKey(name string) string {
+	return releaseHostKey(releaseHostPrefix, name, "app.private_key.path")
+}
+
+const (
+	releaseHostPrefix = "release.host"
+	// Deprecated: hosts listed in github.hosts are configured under github.host.<name>
+	deprecatedReleaseHostPrefix = "github.host"
 )
 
+func releaseHostKey(prefix string, name string, setting string) string {
+	return prefix + "." + name + "." + setting
+}
+
+// GitChannelBranchKey is the branch a named channel tracks
+func GitChannelBranchKey(name string) string {
+	return "git.channel." + name + ".branch"
+}
+
+// GitChannelCommitKey is the commit a named channel is pinned to
+func GitChannelCommitKey(name string) string {
+	return "git.channel." + name + ".commit"
+}
+
 func InitConfig() {
 	viper.SetDefault(Port, DefaultPort)
 
@@ -89,11 +191,25 @@ func InitConfig() {
 	viper.SetDefault(SqliteDBPath, DefaultSqliteDBPath)
 
 	viper.SetDefault(GitType, DefaultGitType)
+	viper.SetDefault(GitUsername, DefaultGitUsername)
+	viper.SetDefault(GitPollInterval, DefaultGitPollInterval)
 
 	viper.SetDefault(JWTDuration, DefaultJWTDuration)
 
 	viper.SetDefault(VaultEnabled, DefaultVaultEnabled)
 
+	viper.SetDefault(PushSyncEnabled, DefaultPushSyncEnabled)
+	viper.SetDefault(PushSyncConcurrency, DefaultPushSyncConcurrency)
+	viper.SetDefault(PushSyncTimeout, DefaultPushSyncTimeout)
+
+	viper.SetDefault(RenderConcurrency, DefaultRenderConcurrency)
+
+	viper.SetDefault(GithubReleaseTagTTL, DefaultGithubReleaseTagTTL)
+	viper.SetDefault(GithubApiUrl, DefaultGithubApiUrl)
+	viper.SetDefault(GithubHostname, DefaultGithubHostname)
+	viper.SetDefault(GithubRateLimitMaxWait, DefaultGithubRateLimitMaxWait)
+	viper.SetDefault(ReleaseLookupTimeout, DefaultReleaseLookupTimeout)
+
 	viper.AutomaticEnv()
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 }
@@ -16,6 +16,8 @@ const (
 	GitKindGithub GitKind = "github"
 	// GitKindStatic is a GitKind of type static.
 	GitKindStatic GitKind = "static"
+	// GitKindGit is a GitKind of type git.
+	GitKindGit GitKind = "git"
 )
 
 var ErrInvalidGitKind = fmt.Errorf("not a valid GitKind, try [%s]", strings.Join(_GitKindNames, ", "))
@@ -23,6 +25,7 @@ var ErrInvalidGitKind = fmt.Errorf("not a valid GitKind, try [%s]", strings.Join
 var _GitKindNames = []string{
 	string(GitKindGithub),
 	string(GitKindStatic),
+	string(GitKindGit),
 }
 
 // GitKindNames returns a list of possible string values of GitKind.
@@ -47,6 +50,7 @@ func (x GitKind) IsValid() bool {
 var _GitKindValue = map[string]GitKind{
 	"github": GitKindGithub,
 	"static": GitKindStatic,
+	"git":    GitKindGit,
 }
 
 // ParseGitKind attempts to convert a string to a GitKind.
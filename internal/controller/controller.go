@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"context"
 	"crypto/hmac"
+	"crypto/rsa"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
+	"path"
 	"strings"
 	"text/template"
 	"time"
@@ -30,6 +33,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/qdm12/reprint"
 	"github.com/rs/zerolog"
+	"golang.org/x/sync/singleflight"
 )
 
 var (
@@ -39,6 +43,8 @@ var (
 	ErrUnknownNodeIDError           = errors.New("unknown node_id")
 	ErrUnknownChallengeIDError      = errors.New("unknown challenge_id")
 	ErrIncorrectChallengeValueError = errors.New("incorrect challenge_value")
+	ErrNodeNotFoundError            = errors.New("node not found")
+	ErrAgentAuthNotConfiguredError  = errors.New("agent auth not configured")
 )
 
 type ControllerConfig struct {
@@ -51,40 +57,162 @@ type ControllerConfig struct {
 	VaultClient        VaultClient
 	HttpClient         *http.Client
 	GithubReleaseToken string
+	// GithubTokenSource takes precedence over GithubReleaseToken, i.e when authenticating as a GitHub App
+	GithubTokenSource GithubTokenSource
+	// GithubApiURL is the API base url of the default github host, defaults to https://api.github.com
+	GithubApiURL string
+	// ReleaseHosts are additional named hosts release seeds can target, i.e GitHub Enterprise or GitLab instances
+	ReleaseHosts map[string]ReleaseHost
+	// GithubRateLimitMaxWait is the longest a github request will wait for an exhausted rate limit to reset
+	GithubRateLimitMaxWait time.Duration
+	// GithubTransport makes github API requests, shared with the git client so all quota use is reported together.
+	// Built from HttpClient and GithubRateLimitMaxWait if nil
+	GithubTransport *GithubTransport
 
 	GithubWebhookSecret []byte
 
+	// Branch is the config repo branch to track, empty for the repo's default branch
+	Branch string
+	// TagPattern, if set, tracks the newest tag matching the glob (i.e release-*) instead of the head of the branch
+	TagPattern string
+	// Path is the directory of the config repo plantr.yaml lives in, i.e machines/plantr in a monorepo. Empty for the
+	// root of the repo
+	Path string
+	// Channels are named refs nodes can be served from instead of the default, i.e stable or canary
+	Channels map[string]Channel
+	// PollInterval is how often Poll checks the config repo for a new commit, zero disables polling
+	PollInterval time.Duration
+
+	AgentAuthSecret     []byte
+	AgentAuthPrivateKey *rsa.PrivateKey
+
+	PushSyncEnabled     bool
+	PushSyncConcurrency int
+	// PushSyncTimeout bounds the push syncs and channel refreshes run in the background after a webhook
+	PushSyncTimeout time.Duration
+
+	RenderConcurrency int
+
+	// ReleaseTagTTL is how long a resolved floating github release tag is reused before checking for a newer release,
+	// zero resolves on every sync
+	ReleaseTagTTL time.Duration
+	// ReleaseLookupTimeout bounds each release API lookup, defaults to 30s
+	ReleaseLookupTimeout time.Duration
+
 	NowFunc  func() time.Time                                       // for unit tests
 	HashFunc func(*parsingv2.Seed, *parsingv2.Node) (string, error) // for unit tests
 }
 
 func NewController(conf ControllerConfig) (*Controller, error) {
+	if _, err := path.Match(conf.TagPattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid tag pattern: %w", err)
+	}
+	// Rooted so the path can't escape the repo
+	configPath := path.Clean("/" + conf.Path)[1:]
+	if configPath == "" {
+		configPath = "."
+	}
+
 	repoUrl := conf.RepoURL
 	if !strings.HasSuffix(repoUrl, ".git") {
 		repoUrl = repoUrl + ".git"
 	}
 	ctrl := &Controller{
-		log:                 conf.Logger,
-		git:                 conf.GitClient,
-		store:               conf.StorageClient,
-		repoUrl:             repoUrl,
-		jwtSigningKey:       conf.JWTSigningKey,
-		jwtDuration:         conf.JWTDuration,
-		nowFunc:             conf.NowFunc,
-		vault:               conf.VaultClient,
-		httpClient:          conf.HttpClient,
-		githubReleaseToken:  conf.GithubReleaseToken,
-		configMu:            &sync.RWMutex{},
-		vaultMu:             &sync.RWMutex{},
-		hashFunc:            conf.HashFunc,
-		githubWebhookSecret: conf.GithubWebhookSecret,
+		log:                  conf.Logger,
+		git:                  conf.GitClient,
+		store:                conf.StorageClient,
+		repoUrl:              repoUrl,
+		branch:               conf.Branch,
+		tagPattern:           conf.TagPattern,
+		configPath:           configPath,
+		pollInterval:         conf.PollInterval,
+		pollMu:               &sync.Mutex{},
+		jwtSigningKey:        conf.JWTSigningKey,
+		jwtDuration:          conf.JWTDuration,
+		nowFunc:              conf.NowFunc,
+		vault:                conf.VaultClient,
+		httpClient:           conf.HttpClient,
+		releaseHosts:         map[string]ReleaseHost{},
+		updateMu:             &sync.Mutex{},
+		configMu:             &sync.RWMutex{},
+		channels:             map[string]Channel{},
+		channelConfigs:       map[string]loadedChannel{},
+		vaultMu:              &sync.RWMutex{},
+		hashFunc:             conf.HashFunc,
+		githubWebhookSecret:  conf.GithubWebhookSecret,
+		agentAuthSecret:      conf.AgentAuthSecret,
+		agentAuthKey:         conf.AgentAuthPrivateKey,
+		pushSyncEnabled:      conf.PushSyncEnabled,
+		pushSyncConcurrency:  conf.PushSyncConcurrency,
+		pushSyncTimeout:      conf.PushSyncTimeout,
+		syncMu:               &sync.Mutex{},
+		renderConcurrency:    conf.RenderConcurrency,
+		releaseGroup:         &singleflight.Group{},
+		releaseTagTTL:        conf.ReleaseTagTTL,
+		releaseLookupTimeout: conf.ReleaseLookupTimeout,
+		releaseTagMu:         &sync.Mutex{},
+		releaseTags:          map[string]resolvedReleaseTag{},
 	}
 
+	for name, channel := range conf.Channels {
+		if name == "" {
+			return nil, fmt.Errorf("channels must be named")
+		}
+		ctrl.channels[name] = channel
+	}
+
+	for name, host := range conf.ReleaseHosts {
+		if name == "" {
+			return nil, fmt.Errorf("release hosts must be named")
+		}
+		ctrl.releaseHosts[name] = ReleaseHost{
+			ApiURL:      apiBaseURL(host.ApiURL),
+			Token:       host.Token,
+			TokenSource: host.TokenSource,
+		}
+	}
+	defaultHost := ReleaseHost{
+		ApiURL:      conf.GithubApiURL,
+		Token:       conf.GithubReleaseToken,
+		TokenSource: conf.GithubTokenSource,
+	}
+	if defaultHost.ApiURL == "" {
+		defaultHost.ApiURL = DefaultGithubApiUrl
+	}
+	defaultHost.ApiURL = apiBaseURL(defaultHost.ApiURL)
+	ctrl.releaseHosts[""] = defaultHost
+
 	if ctrl.nowFunc == nil {
 		ctrl.nowFunc = func() time.Time {
 			return time.Now().UTC()
 		}
 	}
+	ctrl.githubTransport = conf.GithubTransport
+	if ctrl.githubTransport == nil {
+		var githubBase http.RoundTripper
+		if conf.HttpClient != nil {
+			githubBase = conf.HttpClient.Transport
+		}
+		ctrl.githubTransport = NewGithubTransport(GithubTransportConfig{
+			Logger:  conf.Logger,
+			Base:    githubBase,
+			MaxWait: conf.GithubRateLimitMaxWait,
+			NowFunc: ctrl.nowFunc,
+		})
+	}
+	ctrl.githubClient = &http.Client{Transport: ctrl.githubTransport}
+	if ctrl.pushSyncConcurrency <= 0 {
+		ctrl.pushSyncConcurrency = 1
+	}
+	if ctrl.pushSyncTimeout <= 0 {
+		ctrl.pushSyncTimeout = defaultPushSyncTimeout
+	}
+	if ctrl.releaseLookupTimeout <= 0 {
+		ctrl.releaseLookupTimeout = defaultReleaseLookupTimeout
+	}
+	if ctrl.renderConcurrency <= 0 {
+		ctrl.renderConcurrency = 1
+	}
 	if ctrl.hashFunc == nil {
 		ctrl.hashFunc = func(s *parsingv2.Seed, node *parsingv2.Node) (string, error) {
 			return s.ComputeHash(node)
@@ -95,20 +223,61 @@ func NewController(conf ControllerConfig) (*Controller, error) {
 }
 
 type Controller struct {
-	log                zerolog.Logger
-	git                GitClient
-	store              StorageClient
-	repoUrl            string
-	jwtSigningKey      []byte
-	jwtDuration        time.Duration
-	vault              VaultClient
-	httpClient         *http.Client
-	githubReleaseToken string
+	log           zerolog.Logger
+	git           GitClient
+	store         StorageClient
+	repoUrl       string
+	branch        string
+	tagPattern    string
+	configPath    string
+	jwtSigningKey []byte
+	jwtDuration   time.Duration
+	vault         VaultClient
+	httpClient    *http.Client
+
+	// named release hosts, keyed by name with the empty name being the default
+	releaseHosts map[string]ReleaseHost
+	// githubClient is used for github API requests, tracking the rate limit in githubTransport
+	githubClient    *http.Client
+	githubTransport *GithubTransport
 
 	githubWebhookSecret []byte
 
-	configMu *sync.RWMutex
-	config   *parsingv2.Config
+	agentAuthSecret []byte
+	agentAuthKey    *rsa.PrivateKey
+
+	pushSyncEnabled     bool
+	pushSyncConcurrency int
+	pushSyncTimeout     time.Duration
+	// background syncs run one at a time, with at most one queued behind the running one
+	syncMu      *sync.Mutex
+	syncRunning bool
+	syncPending *queuedSync
+
+	renderConcurrency int
+	// coalesces concurrent github release lookups for the same repo@tag
+	releaseGroup *singleflight.Group
+
+	releaseTagTTL        time.Duration
+	releaseLookupTimeout time.Duration
+	releaseTagMu         *sync.Mutex
+	releaseTags          map[string]resolvedReleaseTag
+
+	// serializes changes to the default channel. Reading the pin, loading a commit and swapping it in all happen under
+	// it, so a load can't overwrite a pin made while it was running or land after a newer one
+	updateMu     *sync.Mutex
+	configMu     *sync.RWMutex
+	config       *parsingv2.Config
+	configCommit string
+	// named channels and the config each is serving, guarded by configMu. The default channel is config
+	channels       map[string]Channel
+	channelConfigs map[string]loadedChannel
+
+	pollInterval time.Duration
+	pollMu       *sync.Mutex
+	lastPoll     *pollResult
+	// polls since channels were last checked, only touched by the poll loop
+	channelPolls int
 
 	vaultMu   *sync.RWMutex
 	vaultData *vaultData
@@ -142,6 +311,26 @@ func (c *Controller) logAndHandleError(err error, msg string) error {
 		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 	case errors.Is(err, ErrIncorrectChallengeValueError):
 		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	case errors.Is(err, ErrNodeNotFoundError):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrAgentAuthNotConfiguredError):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrGithubRateLimitedError):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, ErrUnknownChannelError):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrInvalidPromoteError):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrNoChannelError):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrNoCommitError):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrAmbiguousCommitError):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrInvalidCommitError):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrUnknownCommitError):
+		return connect.NewError(connect.CodeNotFound, err)
 	default:
 		return err
 	}
@@ -153,34 +342,129 @@ func (c *Controller) ensureConfig() error {
 		return nil
 	}
 
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+	// Another caller may have loaded it while this one waited
+	if c.currentCommit() != "" {
+		return nil
+	}
+
 	c.log.Debug().Msg("no config loaded, loading now")
-	return c._updateConfig()
+	// Not the callers context, the config is shared with every other caller
+	_, err := c.loadLatestConfig(context.Background(), ConfigTriggerStartup)
+	return err
+}
+
+// _updateConfig loads the config at the latest commit, or the pinned commit if there is one, returning the config it
+// replaced
+func (c *Controller) _updateConfig(ctx context.Context, trigger ConfigTrigger) (*parsingv2.Config, error) {
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+
+	return c.loadLatestConfig(ctx, trigger)
+}
+
+// loadLatestConfig is _updateConfig for callers already holding updateMu
+func (c *Controller) loadLatestConfig(ctx context.Context, trigger ConfigTrigger) (*parsingv2.Config, error) {
+	latest, err := c.pinnedCommit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if latest == "" {
+		c.log.Trace().Msgf("fetching latest commit for %v", c.repoUrl)
+		latest, err = c.latestCommit()
+		if err != nil {
+			return nil, fmt.Errorf("error getting latest commit: %w", err)
+		}
+	}
+
+	return c.loadConfigAt(ctx, latest, trigger)
 }
 
-func (c *Controller) _updateConfig() error {
-	c.log.Trace().Msgf("fetching latest commit for %v", c.repoUrl)
-	latest, err := c.git.GetLatestCommit(c.repoUrl)
+// loadConfigAt serves the config at commit, returning the config it replaced
+func (c *Controller) loadConfigAt(ctx context.Context, commit string, trigger ConfigTrigger) (*parsingv2.Config, error) {
+	config, err := c.parseConfigAt(commit)
 	if err != nil {
-		return fmt.Errorf("error getting latest commit: %w", err)
+		return nil, err
 	}
 
+	return c.setConfig(ctx, config, commit, trigger), nil
+}
+
+func (c *Controller) parseConfigAt(commit string) (*parsingv2.Config, error) {
 	c.log.Trace().Msg("cloning repo")
-	repoFS, err := c.git.CloneAtCommit(c.repoUrl, latest)
+	repoFS, err := c.git.CloneAtCommit(c.repoUrl, commit)
+	if err != nil {
+		return nil, fmt.Errorf("error cloning repo: %w", err)
+	}
+	repoFS, err = fs.Sub(repoFS, c.configPath)
 	if err != nil {
-		return fmt.Errorf("error cloning repo: %w", err)
+		return nil, fmt.Errorf("error scoping repo to %v: %w", c.configPath, err)
 	}
 
 	c.log.Trace().Msg("parsing config from cloned repo")
 	config, err := parsingv2.ParseFS(repoFS)
 	if err != nil {
-		return fmt.Errorf("error parsing config: %w", err)
+		return nil, fmt.Errorf("error parsing config: %w", err)
 	}
 
+	return config, nil
+}
+
+// setConfig swaps in the config for the default channel, recording a revision if the commit changed
+func (c *Controller) setConfig(ctx context.Context, config *parsingv2.Config, commit string, trigger ConfigTrigger) *parsingv2.Config {
 	c.configMu.Lock()
+	previous := c.config
+	previousCommit := c.configCommit
 	c.config = config
+	c.configCommit = commit
 	c.configMu.Unlock()
 
-	return nil
+	if commit != previousCommit {
+		c.recordRevision(ctx, commit, trigger)
+	}
+
+	return previous
+}
+
+// setUnpinnedConfig swaps in a config loaded from the latest commit, unless the default channel was pinned while it
+// was loading. Callers hold updateMu
+func (c *Controller) setUnpinnedConfig(ctx context.Context, config *parsingv2.Config, commit string, trigger ConfigTrigger) (*parsingv2.Config, bool, error) {
+	pinned, err := c.pinnedCommit(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if pinned != "" {
+		c.log.Info().Msgf("config pinned to %v while loading %v, keeping the pin", pinned, commit)
+		return nil, false, nil
+	}
+
+	return c.setConfig(ctx, config, commit, trigger), true, nil
+}
+
+// advanceConfig moves the default channel from one commit to another without reloading, for when the config is known to
+// be the same at both. It does nothing if the channel isn't serving from anymore, i.e it was reloaded in the meantime
+func (c *Controller) advanceConfig(ctx context.Context, from string, to string, trigger ConfigTrigger) bool {
+	c.configMu.Lock()
+	if c.config == nil || c.configCommit != from {
+		c.configMu.Unlock()
+		return false
+	}
+	c.configCommit = to
+	c.configMu.Unlock()
+
+	if to != from {
+		c.recordRevision(ctx, to, trigger)
+	}
+
+	return true
+}
+
+func (c *Controller) currentCommit() string {
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
+
+	return c.configCommit
 }
 
 func (c *Controller) cloneConfig() (*parsingv2.Config, error) {
@@ -235,8 +519,21 @@ func (c *Controller) NewGithubWebhookHandler() (string, http.Handler) {
 }
 
 type githubPushBody struct {
-	Ref   string `json:"ref"`
-	After string `json:"after"`
+	Ref        string `json:"ref"`
+	Before     string `json:"before"`
+	After      string `json:"after"`
+	Deleted    bool   `json:"deleted"`
+	Forced     bool   `json:"forced"`
+	Repository struct {
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+	Commits []githubPushCommit `json:"commits"`
+}
+
+type githubPushCommit struct {
+	Added    []string `json:"added"`
+	Removed  []string `json:"removed"`
+	Modified []string `json:"modified"`
 }
 
 func (c *Controller) handleGithubWebhook(req *http.Request) error {
@@ -250,26 +547,69 @@ func (c *Controller) handleGithubWebhook(req *http.Request) error {
 		return c.logAndHandleError(err, "error unmarshalling body")
 	}
 
-	// TODO: configurable
-	if pushBody.Ref != "refs/heads/main" {
-		return nil
+	queued, err := c.applyPush(req.Context(), pushBody)
+	if err != nil {
+		return err
+	}
+	if queued != nil {
+		c.queueSync(*queued)
 	}
 
-	c.log.Info().Msg("recieved github webhook event for main, refreshing repo")
-	repoFS, err := c.git.CloneAtCommit(c.repoUrl, pushBody.After)
+	return nil
+}
+
+// applyPush updates the default channel for a webhook push under updateMu, returning the sync to queue afterwards, if
+// any
+func (c *Controller) applyPush(ctx context.Context, pushBody githubPushBody) (*queuedSync, error) {
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+
+	pinned, err := c.pinnedCommit(ctx)
 	if err != nil {
-		return c.logAndHandleError(err, "error cloning repo")
+		return nil, c.logAndHandleError(err, "error reading pin")
 	}
-	config, err := parsingv2.ParseFS(repoFS)
+
+	if pinned != "" || !c.tracksRef(pushBody.Ref, pushBody.Repository.DefaultBranch) {
+		if pinned != "" {
+			c.log.Info().Msgf("config pinned to %v, ignoring push to %v", pinned, pushBody.Ref)
+		}
+		if c.channelTracksRef(pushBody.Ref) {
+			c.log.Info().Msgf("recieved github webhook event for %v, refreshing channels", pushBody.Ref)
+			return &queuedSync{}, nil
+		}
+		return nil, nil
+	}
+
+	commit := pushBody.After
+	if c.tagPattern != "" {
+		// Tag pushes carry the tag object rather than the commit, and the pushed tag isn't necessarily the newest.
+		// Deleting a tag falls back to the newest remaining one
+		commit, err = c.latestCommit()
+		if err != nil {
+			return nil, c.logAndHandleError(err, "error getting latest commit")
+		}
+	} else if pushBody.Deleted {
+		return nil, nil
+	} else if c.skipPush(ctx, pushBody) {
+		// The config is unchanged, but channels following the default channel move along with it
+		return &queuedSync{}, nil
+	}
+
+	c.log.Info().Msgf("recieved github webhook event for %v, refreshing repo", pushBody.Ref)
+	config, err := c.parseConfigAt(commit)
 	if err != nil {
-		return c.logAndHandleError(err, "error parsing config")
+		return nil, c.logAndHandleError(err, "error loading config")
 	}
 
-	c.configMu.Lock()
-	c.config = config
-	c.configMu.Unlock()
+	previous, swapped, err := c.setUnpinnedConfig(ctx, config, commit, ConfigTriggerWebhook)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error reading pin")
+	}
+	if !swapped {
+		return nil, nil
+	}
 
-	return nil
+	return &queuedSync{defaultChanged: true, previous: previous}, nil
 }
 
 func (c *Controller) validateGithubRequest(req *http.Request) ([]byte, error) {
@@ -366,6 +706,7 @@ func (c *Controller) Login(ctx context.Context, req *connect.Request[pbv1.LoginR
 			ExpiresAt: c.now().Add(c.jwtDuration).Unix(),
 		},
 		NodeID: req.Msg.NodeId,
+		Role:   token.RoleNode,
 	})
 	if err != nil {
 		return nil, c.logAndHandleError(err, "error generating JWT")
@@ -393,11 +734,19 @@ func (c *Controller) validateLogin(req *pbv1.LoginRequest) error {
 }
 
 func (c *Controller) ForceRefresh(ctx context.Context, req *connect.Request[pbv1.ForceRefreshRequest]) (*connect.Response[pbv1.ForceRefreshResponse], error) {
-	if err := c._updateConfig(); err != nil {
+	previous, err := c._updateConfig(ctx, ConfigTriggerForceRefresh)
+	if err != nil {
 		return nil, err
 	}
 
-	return &connect.Response[pbv1.ForceRefreshResponse]{}, nil
+	results, err := c.syncAfterUpdate(ctx, previous)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error syncing agents")
+	}
+
+	return connect.NewResponse(&pbv1.ForceRefreshResponse{
+		PushResults: results,
+	}), nil
 }
 
 func (c *Controller) GetSyncData(ctx context.Context, req *connect.Request[pbv1.GetSyncDataRequest]) (*connect.Response[pbv1.GetSyncDataResponse], error) {
@@ -406,58 +755,100 @@ func (c *Controller) GetSyncData(ctx context.Context, req *connect.Request[pbv1.
 		return nil, c.logAndHandleError(err, "error getting token claims")
 	}
 
-	seeds, node, err := c.collectSeeds(token.NodeID)
+	seeds, node, commit, err := c.collectSeeds(ctx, token.NodeID)
 	if err != nil {
 		return nil, c.logAndHandleError(err, "error collecting seeds")
 	}
 
-	pbSeeds, err := c.renderSeeds(ctx, node, seeds)
+	desired, err := c.hashSeeds(node, seeds)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error hashing seeds")
+	}
+	desiredSet := set.New(desired...)
+
+	pbSeeds, err := c.renderSeeds(ctx, node, seeds, set.New(req.Msg.InventoryHashes...))
 	if err != nil {
 		return nil, c.logAndHandleError(err, "error rendering seeds")
 	}
 
 	return connect.NewResponse(&pbv1.GetSyncDataResponse{
-		Seeds: pbSeeds,
+		Seeds:  pbSeeds,
+		Commit: commit,
+		RemovedHashes: hlp.Filter(req.Msg.InventoryHashes, func(hash string, _ int) bool {
+			return !desiredSet.Contains(hash)
+		}),
 	}), nil
 }
 
-func (c *Controller) collectSeeds(nodeID string) ([]*parsingv2.Seed, *parsingv2.Node, error) {
-	if err := c.ensureConfig(); err != nil {
-		return nil, nil, fmt.Errorf("error ensuring config: %w", err)
-	}
-	conf, err := c.cloneConfig()
+// collectSeeds gathers the seeds for the node from its channel, along with the commit they came from
+func (c *Controller) collectSeeds(ctx context.Context, nodeID string) ([]*parsingv2.Seed, *parsingv2.Node, string, error) {
+	conf, commit, err := c.nodeConfig(ctx, nodeID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error cloning config: %w", err)
+		return nil, nil, "", err
 	}
 
 	c.log.Trace().Msg("finding node from config")
-	var node *parsingv2.Node
-	for _, n := range conf.Nodes {
-		if n.ID == nodeID {
-			node = n
-			break
-		}
-	}
+	node := c.findNode(conf, nodeID)
 	if node == nil {
-		return nil, nil, ErrUnknownNodeIDError
+		return nil, nil, "", ErrUnknownNodeIDError
+	}
+
+	seedList, err := c.nodeSeeds(conf, node)
+	if err != nil {
+		return nil, nil, "", err
 	}
 
+	if err := c.resolveReleaseTags(ctx, seedList); err != nil {
+		return nil, nil, "", err
+	}
+
+	return seedList, node, commit, nil
+}
+
+func (c *Controller) nodeSeeds(conf *parsingv2.Config, node *parsingv2.Node) ([]*parsingv2.Seed, error) {
 	c.log.Trace().Msg("collecting seeds from defined roles")
 	seedList := []*parsingv2.Seed{}
 	for _, roleName := range node.Roles {
 		c.log.Trace().Msgf("collecting from role %v", roleName)
 		seeds, ok := conf.Roles[roleName]
 		if !ok {
-			return nil, nil, fmt.Errorf("node %v references unknown role %v", nodeID, roleName)
+			return nil, fmt.Errorf("node %v references unknown role %v", node.ID, roleName)
 		}
 
 		seedList = append(seedList, seeds...)
 	}
 
-	return seedList, node, nil
+	return seedList, nil
 }
 
-func (c *Controller) renderSeeds(ctx context.Context, node *parsingv2.Node, seeds []*parsingv2.Seed) ([]*pbv1.Seed, error) {
+func (c *Controller) hashSeeds(node *parsingv2.Node, seeds []*parsingv2.Seed) ([]string, error) {
+	hashes := []string{}
+	for _, seed := range seeds {
+		hash, err := c.hashFunc(seed, node)
+		if err != nil {
+			return nil, fmt.Errorf("error hashing seed: %w", err)
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, nil
+}
+
+// alwaysSync reports if a seed must be sent regardless of the agent inventory, as its hash doesn't capture what will
+// actually be installed
+func alwaysSync(seed *parsingv2.Seed) bool {
+	switch concrete := seed.Element.(type) {
+	case *parsingv2.GoInstall:
+		// No version means "latest", which the agent re-checks on every sync
+		return concrete.Version == nil
+	default:
+		return false
+	}
+}
+
+// renderSeeds renders the given seeds for the node, skipping any whose hash is in knownHashes since the agent has
+// already applied them
+func (c *Controller) renderSeeds(ctx context.Context, node *parsingv2.Node, seeds []*parsingv2.Seed, knownHashes *set.Set[string]) ([]*pbv1.Seed, error) {
 	// Do this once per render instead of once per config file
 	if err := c.ensureVault(ctx); err != nil {
 		return nil, fmt.Errorf("error ensuring vault data: %w", err)
@@ -476,81 +867,98 @@ func (c *Controller) renderSeeds(ctx context.Context, node *parsingv2.Node, seed
 
 	renderedSeeds := set.New[string]()
 
-	outSeeds := []*pbv1.Seed{}
+	type pendingSeed struct {
+		seed        *parsingv2.Seed
+		displayName string
+		hash        string
+	}
+
+	// Work out what needs rendering up front, this is cheap and keeps the output in config order
+	pending := []pendingSeed{}
 	for _, seed := range seeds {
 		displayName, err := seed.DisplayName(node)
 		if err != nil {
 			return nil, fmt.Errorf("error getting display name for seed: %w", err)
 		}
 
-		namedError := func(err error) error {
-			return fmt.Errorf("error rendering %v: %w", displayName, err)
-		}
-
 		c.log.Debug().Msgf("rendering seed %v", displayName)
 		hash, err := c.hashFunc(seed, node)
 		if err != nil {
-			return nil, namedError(err)
+			return nil, fmt.Errorf("error rendering %v: %w", displayName, err)
 		}
 		if renderedSeeds.Contains(hash) {
 			c.log.Debug().Msg("seed with same hash already rendered, skipping")
 			continue
 		}
-
-		outSeed := &pbv1.Seed{
-			Metadata: &pbv1.Seed_Metadata{
-				DisplayName: displayName,
-				Hash:        hash,
-			},
+		if knownHashes.Contains(hash) && !alwaysSync(seed) {
+			c.log.Debug().Msg("seed already in agent inventory, skipping")
+			continue
 		}
 
-		switch concrete := seed.Element.(type) {
-		case *parsingv2.ConfigFile:
-			s, err := c.renderSeed_configFile(concrete, node, vaultData, namedSeeds)
-			if err != nil {
-				return nil, namedError(err)
-			}
-			outSeed.Element = s.Element
-		case *parsingv2.GithubRelease:
-			s, err := c.renderSeed_githubRelease(ctx, concrete, node)
-			if err != nil {
-				return nil, namedError(err)
-			}
-			outSeed.Element = s.Element
-		case *parsingv2.SystemPackage:
-			s, err := c.renderSeed_systemPackage(concrete, node)
-			if err != nil {
-				return nil, namedError(err)
-			}
-			outSeed.Element = s.Element
-		case *parsingv2.GitRepo:
-			s, err := c.renderSeed_gitRepo(concrete, node)
+		pending = append(pending, pendingSeed{seed: seed, displayName: displayName, hash: hash})
+	}
+
+	outSeeds := make([]*pbv1.Seed, len(pending))
+	errs := make([]error, len(pending))
+	sem := make(chan struct{}, c.renderConcurrency)
+	wg := sync.WaitGroup{}
+
+	for i, p := range pending {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			s, err := c.renderSeed(ctx, p.seed, node, vaultData, namedSeeds)
 			if err != nil {
-				return nil, namedError(err)
+				errs[i] = fmt.Errorf("error rendering %v: %w", p.displayName, err)
+				return
 			}
-			outSeed.Element = s.Element
-		case *parsingv2.Golang:
-			s := c.renderSeed_golang(concrete)
-			outSeed.Element = s.Element
-		case *parsingv2.GoInstall:
-			s := c.renderSeed_goInstall(concrete)
-			outSeed.Element = s.Element
-		case *parsingv2.UrlDownload:
-			s, err := c.renderSeed_urlDownload(concrete, node)
-			if err != nil {
-				return nil, namedError(err)
+			outSeeds[i] = &pbv1.Seed{
+				Metadata: &pbv1.Seed_Metadata{
+					DisplayName: p.displayName,
+					Hash:        p.hash,
+				},
+				Element: s.Element,
 			}
-			outSeed.Element = s.Element
-		default:
-			return nil, fmt.Errorf("unhandled seed type of %T", concrete)
-		}
+		}()
+	}
+
+	wg.Wait()
 
-		outSeeds = append(outSeeds, outSeed)
+	// Report the first failure in config order, so the same bad config always produces the same error
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return outSeeds, nil
 }
 
+func (c *Controller) renderSeed(ctx context.Context, seed *parsingv2.Seed, node *parsingv2.Node, vaultData map[string]any, namedSeeds *set.Set[string]) (*pbv1.Seed, error) {
+	switch concrete := seed.Element.(type) {
+	case *parsingv2.ConfigFile:
+		return c.renderSeed_configFile(concrete, node, vaultData, namedSeeds)
+	case *parsingv2.GithubRelease:
+		return c.renderSeed_githubRelease(ctx, concrete, node)
+	case *parsingv2.SystemPackage:
+		return c.renderSeed_systemPackage(concrete, node)
+	case *parsingv2.GitRepo:
+		return c.renderSeed_gitRepo(concrete, node)
+	case *parsingv2.Golang:
+		return c.renderSeed_golang(concrete), nil
+	case *parsingv2.GoInstall:
+		return c.renderSeed_goInstall(concrete), nil
+	case *parsingv2.UrlDownload:
+		return c.renderSeed_urlDownload(concrete, node)
+	default:
+		return nil, fmt.Errorf("unhandled seed type of %T", concrete)
+	}
+}
+
 func (c *Controller) renderSeed_configFile(file *parsingv2.ConfigFile, node *parsingv2.Node, vaultData map[string]any, namedSeeds *set.Set[string]) (*pbv1.Seed, error) {
 	functions := template.FuncMap{
 		"HasRole": func(roleName string) bool {
@@ -661,6 +1069,11 @@ func (c *Controller) renderSeed_urlDownload(urlDownload *parsingv2.UrlDownload,
 		return nil, err
 	}
 
+	sig, err := renderSignature(urlDownload.Signature, url)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pbv1.Seed{
 		Element: &pbv1.Seed_UrlDownload{
 			UrlDownload: &pbv1.UrlDownload{
@@ -668,6 +1081,8 @@ func (c *Controller) renderSeed_urlDownload(urlDownload *parsingv2.UrlDownload,
 				DownloadUrl:          url,
 				DestinationDirectory: node.BinDir,
 				ArchiveRelease:       urlDownload.ArchiveRelease,
+				Sha256:               urlDownload.GetSha256(node),
+				Signature:            sig,
 			},
 		},
 	}, nil
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/token"
+)
+
+const (
+	agentTokenDuration = 5 * time.Minute
+)
+
+// newAgentToken issues a short lived token the controller can present to the given node's agent. Tokens are RS256
+// signed when a private key is configured, otherwise they are signed with the shared secret
+func (c *Controller) newAgentToken(nodeID string) (string, error) {
+	claims := token.Token{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  nodeID,
+			ExpiresAt: c.now().Add(agentTokenDuration).Unix(),
+		},
+		Role: token.RoleController,
+	}
+
+	switch true {
+	case c.agentAuthKey != nil:
+		return token.GenerateJWTRSA(c.agentAuthKey, claims)
+	case len(c.agentAuthSecret) != 0:
+		return token.GenerateJWT(c.agentAuthSecret, claims)
+	default:
+		return "", fmt.Errorf("%w: an agent auth secret or private key is required", ErrAgentAuthNotConfiguredError)
+	}
+}
+
+// IssueAgentToken hands an admin a short lived token for a node's agent, so tools like plantr sync --agent can reach
+// an agent without ever holding the agent auth secret or private key
+func (c *Controller) IssueAgentToken(ctx context.Context, req *connect.Request[pbv1.IssueAgentTokenRequest]) (*connect.Response[pbv1.IssueAgentTokenResponse], error) {
+	if req.Msg.NodeId == "" {
+		return nil, c.logAndHandleError(ErrNoNodeIDError, "error validating")
+	}
+
+	if err := c.ensureConfig(); err != nil {
+		return nil, c.logAndHandleError(err, "error ensuring config")
+	}
+	conf, err := c.cloneConfig()
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error cloning config")
+	}
+
+	node := c.findNode(conf, req.Msg.NodeId)
+	if node == nil {
+		return nil, c.logAndHandleError(fmt.Errorf("%w: %v", ErrNodeNotFoundError, req.Msg.NodeId), "unable to find node")
+	}
+
+	tokenStr, err := c.newAgentToken(node.ID)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error issuing agent token")
+	}
+
+	c.log.Info().Msgf("issued agent token for %v", node.ID)
+	return connect.NewResponse(&pbv1.IssueAgentTokenResponse{
+		Token: tokenStr,
+	}), nil
+}
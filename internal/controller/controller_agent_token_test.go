@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/nicjohnson145/plantr/internal/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestController_NewAgentToken(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nodeID = "some-node-id"
+		now    = time.Date(2095, time.May, 15, 15, 30, 0, 0, time.UTC)
+	)
+
+	wantToken := &token.Token{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  nodeID,
+			ExpiresAt: now.Add(agentTokenDuration).Unix(),
+		},
+		Role: token.RoleController,
+	}
+	nowFunc := func() time.Time {
+		return now
+	}
+
+	t.Run("shared secret", func(t *testing.T) {
+		t.Parallel()
+
+		secret := []byte(`some-shared-secret`)
+		ctrl := newControllerWithConfig(t, ControllerConfig{AgentAuthSecret: secret, NowFunc: nowFunc}, nil)
+
+		tokenStr, err := ctrl.newAgentToken(nodeID)
+		require.NoError(t, err)
+
+		got, err := token.ParseJWT(tokenStr, secret)
+		require.NoError(t, err)
+		require.Equal(t, wantToken, got)
+	})
+
+	t.Run("private key", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		ctrl := newControllerWithConfig(t, ControllerConfig{AgentAuthPrivateKey: key, NowFunc: nowFunc}, nil)
+
+		tokenStr, err := ctrl.newAgentToken(nodeID)
+		require.NoError(t, err)
+
+		got, err := token.ParseJWTRSA(tokenStr, &key.PublicKey)
+		require.NoError(t, err)
+		require.Equal(t, wantToken, got)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{NowFunc: nowFunc}, nil)
+
+		_, err := ctrl.newAgentToken(nodeID)
+		require.ErrorIs(t, err, ErrAgentAuthNotConfiguredError)
+	})
+}
+
+func TestController_IssueAgentToken(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte(`some-shared-secret`)
+	config := &parsingv2.Config{
+		Nodes: []*parsingv2.Node{
+			{ID: "node-one", Hostname: "host-one"},
+		},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{AgentAuthSecret: secret}, config)
+
+		resp, err := ctrl.IssueAgentToken(context.Background(), connect.NewRequest(&pbv1.IssueAgentTokenRequest{NodeId: "node-one"}))
+		require.NoError(t, err)
+
+		got, err := token.ParseJWT(resp.Msg.Token, secret)
+		require.NoError(t, err)
+		require.Equal(t, "node-one", got.Audience)
+		require.Equal(t, token.RoleController, got.Role)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{AgentAuthSecret: secret}, config)
+
+		_, err := ctrl.IssueAgentToken(context.Background(), connect.NewRequest(&pbv1.IssueAgentTokenRequest{NodeId: "node-two"}))
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{}, config)
+
+		_, err := ctrl.IssueAgentToken(context.Background(), connect.NewRequest(&pbv1.IssueAgentTokenRequest{NodeId: "node-one"}))
+		require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
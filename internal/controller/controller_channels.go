@@ -0,0 +1,333 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/qdm12/reprint"
+	"github.com/spf13/viper"
+)
+
+var (
+	ErrUnknownChannelError = errors.New("unknown channel")
+	ErrInvalidPromoteError = errors.New("invalid promotion")
+	ErrNoChannelError      = errors.New("channel is required")
+)
+
+// Channel is a config repo ref some nodes are served from instead of the default, i.e a canary branch or a stable
+// commit. A channel with neither a branch nor a commit follows the default channel until something is promoted to it
+type Channel struct {
+	// Branch to track
+	Branch string
+	// Commit pins the channel
+	Commit string
+}
+
+type loadedChannel struct {
+	config *parsingv2.Config
+	commit string
+}
+
+// NewChannelsFromEnv reads the named channels listed in git.channels, each configured under git.channel.<name>
+func NewChannelsFromEnv() (map[string]Channel, error) {
+	channels := map[string]Channel{}
+	for _, name := range strings.Split(viper.GetString(GitChannels), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		channel := Channel{
+			Branch: viper.GetString(GitChannelBranchKey(name)),
+			Commit: viper.GetString(GitChannelCommitKey(name)),
+		}
+		if channel.Branch != "" && channel.Commit != "" {
+			return nil, fmt.Errorf("channel %v: only one of %v and %v can be set", name, GitChannelBranchKey(name), GitChannelCommitKey(name))
+		}
+		channels[name] = channel
+	}
+
+	return channels, nil
+}
+
+func (c *Controller) PromoteChannel(ctx context.Context, req *connect.Request[pbv1.PromoteChannelRequest]) (*connect.Response[pbv1.PromoteChannelResponse], error) {
+	if req.Msg.To == "" {
+		return nil, c.logAndHandleError(fmt.Errorf("%w: the default channel can't be promoted to, pin it instead", ErrInvalidPromoteError), "error validating")
+	}
+	if req.Msg.From == req.Msg.To {
+		return nil, c.logAndHandleError(fmt.Errorf("%w: can't promote %v to itself", ErrInvalidPromoteError, req.Msg.To), "error validating")
+	}
+	if _, ok := c.channels[req.Msg.To]; !ok {
+		return nil, c.logAndHandleError(fmt.Errorf("%w: %v", ErrUnknownChannelError, req.Msg.To), "error validating")
+	}
+
+	commit, err := c.servingCommit(ctx, req.Msg.From)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error getting commit to promote")
+	}
+
+	err = c.store.WriteChannelPin(ctx, &DBChannelPin{
+		Channel:  req.Msg.To,
+		Commit:   commit,
+		PinnedAt: c.now(),
+	})
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error writing channel pin")
+	}
+
+	c.log.Info().Msgf("promoted %v to channel %v", commit, req.Msg.To)
+	previous, current, err := c.loadChannel(ctx, req.Msg.To)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error loading channel")
+	}
+
+	resp := &pbv1.PromoteChannelResponse{
+		Commit: commit,
+	}
+	if c.pushSyncEnabled {
+		resp.PushResults = c.pushSync(ctx, req.Msg.To, previous, current)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ResetChannel drops a channel's promotion, so it goes back to following its branch, commit or the default channel
+func (c *Controller) ResetChannel(ctx context.Context, req *connect.Request[pbv1.ResetChannelRequest]) (*connect.Response[pbv1.ResetChannelResponse], error) {
+	if req.Msg.Channel == "" {
+		return nil, c.logAndHandleError(fmt.Errorf("%w, the default channel is unpinned instead", ErrNoChannelError), "error validating")
+	}
+	if _, ok := c.channels[req.Msg.Channel]; !ok {
+		return nil, c.logAndHandleError(fmt.Errorf("%w: %v", ErrUnknownChannelError, req.Msg.Channel), "error validating")
+	}
+
+	if err := c.store.DeleteChannelPin(ctx, req.Msg.Channel); err != nil {
+		return nil, c.logAndHandleError(err, "error deleting channel pin")
+	}
+
+	c.log.Info().Msgf("reset channel %v", req.Msg.Channel)
+	previous, current, err := c.loadChannel(ctx, req.Msg.Channel)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error loading channel")
+	}
+
+	resp := &pbv1.ResetChannelResponse{
+		Commit: c.loadedCommit(req.Msg.Channel),
+	}
+	if c.pushSyncEnabled && previous != current {
+		resp.PushResults = c.pushSync(ctx, req.Msg.Channel, previous, current)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// nodeConfig returns the config and commit of the channel the node is served from. Channel assignments always come
+// from the default channel, so a change on another channel can't move nodes between channels
+func (c *Controller) nodeConfig(ctx context.Context, nodeID string) (*parsingv2.Config, string, error) {
+	if err := c.ensureConfig(); err != nil {
+		return nil, "", fmt.Errorf("error ensuring config: %w", err)
+	}
+	conf, err := c.cloneConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("error cloning config: %w", err)
+	}
+
+	node := c.findNode(conf, nodeID)
+	if node == nil {
+		return nil, "", ErrUnknownNodeIDError
+	}
+	if node.Channel == "" {
+		return conf, c.currentCommit(), nil
+	}
+
+	if _, ok := c.channels[node.Channel]; !ok {
+		return nil, "", fmt.Errorf("node %v: %w: %v", nodeID, ErrUnknownChannelError, node.Channel)
+	}
+	if err := c.ensureChannel(ctx, node.Channel); err != nil {
+		return nil, "", err
+	}
+
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
+
+	loaded := c.channelConfigs[node.Channel]
+	out := &parsingv2.Config{}
+	if err := reprint.FromTo(loaded.config, out); err != nil {
+		return nil, "", fmt.Errorf("error cloning config: %w", err)
+	}
+	if c.findNode(out, nodeID) == nil {
+		return nil, "", fmt.Errorf("node %v not in channel %v at %v: %w", nodeID, node.Channel, loaded.commit, ErrUnknownNodeIDError)
+	}
+
+	return out, loaded.commit, nil
+}
+
+// servingCommit is the commit the channel is currently serving, loading it if needed
+func (c *Controller) servingCommit(ctx context.Context, channel string) (string, error) {
+	if channel == "" {
+		if err := c.ensureConfig(); err != nil {
+			return "", fmt.Errorf("error ensuring config: %w", err)
+		}
+		return c.currentCommit(), nil
+	}
+
+	if _, ok := c.channels[channel]; !ok {
+		return "", fmt.Errorf("%w: %v", ErrUnknownChannelError, channel)
+	}
+	if err := c.ensureChannel(ctx, channel); err != nil {
+		return "", err
+	}
+	return c.loadedCommit(channel), nil
+}
+
+// loadedCommit is the commit the channel is serving, empty if it hasn't been loaded
+func (c *Controller) loadedCommit(channel string) string {
+	if channel == "" {
+		return c.currentCommit()
+	}
+
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
+
+	return c.channelConfigs[channel].commit
+}
+
+func (c *Controller) ensureChannel(ctx context.Context, channel string) error {
+	c.configMu.RLock()
+	_, ok := c.channelConfigs[channel]
+	c.configMu.RUnlock()
+	if ok {
+		return nil
+	}
+
+	c.log.Debug().Msgf("channel %v not loaded, loading now", channel)
+	_, _, err := c.loadChannel(ctx, channel)
+	return err
+}
+
+// loadChannel loads the channel at the commit it should be serving, returning the config it replaced and the one it
+// loaded. Nothing is cloned if the channel is already at that commit
+func (c *Controller) loadChannel(ctx context.Context, channel string) (*parsingv2.Config, *parsingv2.Config, error) {
+	commit, err := c.channelCommit(ctx, channel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting commit for channel %v: %w", channel, err)
+	}
+
+	c.configMu.RLock()
+	loaded, ok := c.channelConfigs[channel]
+	defaultConfig, defaultCommit := c.config, c.configCommit
+	c.configMu.RUnlock()
+	if ok && loaded.commit == commit {
+		return loaded.config, loaded.config, nil
+	}
+
+	// Parsed configs are never modified, so a channel at the same commit as the default can share it
+	config := defaultConfig
+	if config == nil || commit != defaultCommit {
+		c.log.Trace().Msgf("loading %v for channel %v", commit, channel)
+		config, err = c.parseConfigAt(commit)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	c.configMu.Lock()
+	defer c.configMu.Unlock()
+
+	previous := c.channelConfigs[channel].config
+	c.channelConfigs[channel] = loadedChannel{config: config, commit: commit}
+
+	return previous, config, nil
+}
+
+// channelCommit resolves the commit a channel should be serving. A promotion takes precedence over the channel's own
+// configuration
+func (c *Controller) channelCommit(ctx context.Context, channel string) (string, error) {
+	pin, err := c.store.ReadChannelPin(ctx, channel)
+	if err != nil {
+		return "", fmt.Errorf("error reading channel pin: %w", err)
+	}
+	if pin != nil {
+		return pin.Commit, nil
+	}
+
+	conf := c.channels[channel]
+	switch {
+	case conf.Commit != "":
+		return conf.Commit, nil
+	case conf.Branch != "":
+		return c.git.GetBranchCommit(c.repoUrl, conf.Branch)
+	default:
+		if err := c.ensureConfig(); err != nil {
+			return "", fmt.Errorf("error ensuring config: %w", err)
+		}
+		return c.currentCommit(), nil
+	}
+}
+
+// refreshChannels reloads every loaded channel, i.e after the default channel or a channel's branch moves. Channels
+// that haven't been loaded yet will pick up the latest commit when they are
+func (c *Controller) refreshChannels(ctx context.Context) []*pbv1.PushSyncResult {
+	c.configMu.RLock()
+	names := make([]string, 0, len(c.channelConfigs))
+	for name := range c.channelConfigs {
+		names = append(names, name)
+	}
+	c.configMu.RUnlock()
+	slices.Sort(names)
+
+	results := []*pbv1.PushSyncResult{}
+	for _, name := range names {
+		previous, current, err := c.loadChannel(ctx, name)
+		if err != nil {
+			c.log.Err(err).Msgf("error refreshing channel %v", name)
+			continue
+		}
+		if c.pushSyncEnabled && previous != current {
+			results = append(results, c.pushSync(ctx, name, previous, current)...)
+		}
+	}
+
+	return results
+}
+
+// channelTracksRef reports if a push to ref moves any channel's branch
+func (c *Controller) channelTracksRef(ref string) bool {
+	for _, channel := range c.channels {
+		if channel.Branch != "" && ref == "refs/heads/"+channel.Branch {
+			return true
+		}
+	}
+	return false
+}
+
+// channelTracksBranch reports if any channel follows a branch, and so can move without the default channel moving
+func (c *Controller) channelTracksBranch() bool {
+	for _, channel := range c.channels {
+		if channel.Branch != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// inChannel reports if node is served from channel. Assignments come from the default channel's config
+func (c *Controller) inChannel(channel string, node *parsingv2.Node) bool {
+	if channel == "" {
+		return node.Channel == ""
+	}
+
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
+
+	if c.config == nil {
+		return false
+	}
+	assigned := c.findNode(c.config, node.ID)
+	return assigned != nil && assigned.Channel == channel
+}
@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"connectrpc.com/connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChannelsFromEnv(t *testing.T) {
+	t.Run("smokes", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+		viper.Set(GitChannels, "canary, stable")
+		viper.Set(GitChannelBranchKey("canary"), "canary")
+
+		channels, err := NewChannelsFromEnv()
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[string]Channel{
+				"canary": {Branch: "canary"},
+				"stable": {},
+			},
+			channels,
+		)
+	})
+
+	t.Run("branch and commit", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+		viper.Set(GitChannels, "stable")
+		viper.Set(GitChannelBranchKey("stable"), "main")
+		viper.Set(GitChannelCommitKey("stable"), "some-commit")
+
+		_, err := NewChannelsFromEnv()
+		require.ErrorContains(t, err, "only one of")
+	})
+}
+
+// channelRepo is a config repo installing pkg on every node, with nodes on the default, canary and stable channels and
+// one on a channel that isn't configured
+func channelRepo(pkg string) fs.FS {
+	node := func(id string, channel string) string {
+		return fmt.Sprintf(`
+- id: %v
+  public_key_b64: c29tZS1rZXk=
+  user_home: /home/fake-user
+  roles:
+  - base
+  os: linux
+  arch: amd64
+  package_manager: apt
+  channel: %v`, id, channel)
+	}
+
+	config := `
+roles:
+  base:
+    seeds:
+    - system_package:
+        apt:
+          name: ` + pkg + `
+nodes:` + node("default-node", `""`) + node("canary-node", "canary") + node("stable-node", "stable") + node("lost-node", "nope")
+
+	return fstest.MapFS{
+		"plantr.yaml": &fstest.MapFile{Data: []byte(config)},
+	}
+}
+
+func TestController_Channels(t *testing.T) {
+	t.Parallel()
+
+	const repoUrl = "https://github.com/some/config.git"
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	newChannelController := func(t *testing.T) (*Controller, *atomic.Int32) {
+		t.Helper()
+
+		branchCalls := &atomic.Int32{}
+		repos := map[string]fs.FS{
+			"main-commit":   channelRepo("main-pkg"),
+			"canary-commit": channelRepo("canary-pkg"),
+		}
+		gitClient := NewMockGitClient(t)
+		gitClient.EXPECT().GetLatestCommit(repoUrl).Return("main-commit", nil).Maybe()
+		gitClient.EXPECT().GetBranchCommit(repoUrl, "canary").RunAndReturn(func(string, string) (string, error) {
+			branchCalls.Add(1)
+			return "canary-commit", nil
+		}).Maybe()
+		gitClient.EXPECT().CloneAtCommit(repoUrl, mock.Anything).RunAndReturn(func(_ string, commit string) (fs.FS, error) {
+			return repos[commit], nil
+		}).Maybe()
+
+		pins := map[string]*DBChannelPin{}
+		storage := NewMockStorageClient(t)
+		storage.EXPECT().ReadChannelPin(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, channel string) (*DBChannelPin, error) {
+			return pins[channel], nil
+		}).Maybe()
+		storage.EXPECT().WriteChannelPin(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, pin *DBChannelPin) error {
+			pins[pin.Channel] = pin
+			return nil
+		}).Maybe()
+		storage.EXPECT().DeleteChannelPin(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, channel string) error {
+			delete(pins, channel)
+			return nil
+		}).Maybe()
+		storage.EXPECT().WriteConfigRevision(mock.Anything, mock.Anything).Return(nil).Maybe()
+		storage.EXPECT().PruneConfigRevisions(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+		ctrl := newControllerWithConfig(
+			t,
+			ControllerConfig{
+				GitClient:     gitClient,
+				StorageClient: storage,
+				RepoURL:       repoUrl,
+				Channels: map[string]Channel{
+					"canary": {Branch: "canary"},
+					"stable": {},
+				},
+				NowFunc: func() time.Time {
+					return now
+				},
+			},
+			nil,
+		)
+		return ctrl, branchCalls
+	}
+
+	served := func(t *testing.T, ctrl *Controller, nodeID string) (string, string) {
+		t.Helper()
+
+		seeds, _, commit, err := ctrl.collectSeeds(context.Background(), nodeID)
+		require.NoError(t, err)
+		require.Len(t, seeds, 1)
+		return seeds[0].Element.(*parsingv2.SystemPackage).Apt.Name, commit
+	}
+
+	t.Run("serves nodes from their channel", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, _ := newChannelController(t)
+
+		pkg, commit := served(t, ctrl, "default-node")
+		require.Equal(t, "main-pkg", pkg)
+		require.Equal(t, "main-commit", commit)
+
+		pkg, commit = served(t, ctrl, "canary-node")
+		require.Equal(t, "canary-pkg", pkg)
+		require.Equal(t, "canary-commit", commit)
+
+		// Nothing promoted yet, so stable follows the default channel
+		pkg, commit = served(t, ctrl, "stable-node")
+		require.Equal(t, "main-pkg", pkg)
+		require.Equal(t, "main-commit", commit)
+
+		_, _, _, err := ctrl.collectSeeds(context.Background(), "lost-node")
+		require.ErrorIs(t, err, ErrUnknownChannelError)
+	})
+
+	t.Run("promote", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, _ := newChannelController(t)
+
+		resp, err := ctrl.PromoteChannel(context.Background(), connect.NewRequest(&pbv1.PromoteChannelRequest{
+			From: "canary",
+			To:   "stable",
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "canary-commit", resp.Msg.Commit)
+
+		pkg, commit := served(t, ctrl, "stable-node")
+		require.Equal(t, "canary-pkg", pkg)
+		require.Equal(t, "canary-commit", commit)
+
+		// Refreshing leaves the promoted commit in place, rather than following the default channel again
+		require.Empty(t, ctrl.refreshChannels(context.Background()))
+		_, commit = served(t, ctrl, "stable-node")
+		require.Equal(t, "canary-commit", commit)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, _ := newChannelController(t)
+
+		_, err := ctrl.PromoteChannel(context.Background(), connect.NewRequest(&pbv1.PromoteChannelRequest{To: "canary"}))
+		require.NoError(t, err)
+		_, commit := served(t, ctrl, "canary-node")
+		require.Equal(t, "main-commit", commit)
+
+		// Back to tracking its branch
+		resp, err := ctrl.ResetChannel(context.Background(), connect.NewRequest(&pbv1.ResetChannelRequest{Channel: "canary"}))
+		require.NoError(t, err)
+		require.Equal(t, "canary-commit", resp.Msg.Commit)
+		pkg, commit := served(t, ctrl, "canary-node")
+		require.Equal(t, "canary-pkg", pkg)
+		require.Equal(t, "canary-commit", commit)
+
+		_, err = ctrl.ResetChannel(context.Background(), connect.NewRequest(&pbv1.ResetChannelRequest{}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = ctrl.ResetChannel(context.Background(), connect.NewRequest(&pbv1.ResetChannelRequest{Channel: "nope"}))
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("poll checks branches on a slower schedule", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, branchCalls := newChannelController(t)
+		_, commit := served(t, ctrl, "canary-node")
+		require.Equal(t, "canary-commit", commit)
+		require.Equal(t, int32(1), branchCalls.Load())
+
+		// The default channel hasn't moved, so the canary branch is only checked every few polls
+		for range channelPollEvery - 1 {
+			ctrl.pollOnce(context.Background())
+		}
+		require.Equal(t, int32(1), branchCalls.Load())
+		ctrl.pollOnce(context.Background())
+		require.Equal(t, int32(2), branchCalls.Load())
+	})
+
+	t.Run("promote validation", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, _ := newChannelController(t)
+
+		_, err := ctrl.PromoteChannel(context.Background(), connect.NewRequest(&pbv1.PromoteChannelRequest{
+			From: "canary",
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = ctrl.PromoteChannel(context.Background(), connect.NewRequest(&pbv1.PromoteChannelRequest{
+			From: "canary",
+			To:   "nope",
+		}))
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/lithammer/dedent"
 	"github.com/nicjohnson145/hlp"
+	"github.com/nicjohnson145/hlp/set"
 	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
 	"github.com/nicjohnson145/plantr/internal/parsingv2"
 	"github.com/stretchr/testify/require"
@@ -45,7 +46,7 @@ func TestRenderSeedConfigFile(t *testing.T) {
 			},
 		}
 
-		pbSeeds, err := ctrl.renderSeeds(context.Background(), node, seeds)
+		pbSeeds, err := ctrl.renderSeeds(context.Background(), node, seeds, set.New[string]())
 		require.NoError(t, err)
 
 		wantPb := []*pbv1.Seed{
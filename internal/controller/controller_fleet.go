@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/interceptors"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *Controller) ReportSync(ctx context.Context, req *connect.Request[pbv1.ReportSyncRequest]) (*connect.Response[pbv1.ReportSyncResponse], error) {
+	token, err := interceptors.ClaimsFromCtx(ctx)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error getting token claims")
+	}
+
+	if err := c.ensureConfig(); err != nil {
+		return nil, c.logAndHandleError(err, "error ensuring config")
+	}
+	conf, err := c.cloneConfig()
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error cloning config")
+	}
+	if c.findNode(conf, token.NodeID) == nil {
+		return nil, c.logAndHandleError(ErrUnknownNodeIDError, "unable to find node matching ID")
+	}
+
+	status := &NodeStatus{
+		DBNodeStatus: DBNodeStatus{
+			NodeID:          token.NodeID,
+			LastSeen:        c.now(),
+			LastSyncSuccess: req.Msg.Result == pbv1.SyncResult_SYNC_RESULT_SUCCESS,
+			AppliedCommit:   req.Msg.Commit,
+		},
+	}
+	for _, failure := range req.Msg.FailingSeeds {
+		status.SeedFailures = append(status.SeedFailures, DBSeedFailure{
+			NodeID:      token.NodeID,
+			Hash:        failure.Hash,
+			DisplayName: failure.DisplayName,
+			Error:       failure.Error,
+		})
+	}
+
+	if err := c.store.WriteNodeStatus(ctx, status); err != nil {
+		return nil, c.logAndHandleError(err, "error writing node status")
+	}
+
+	return connect.NewResponse(&pbv1.ReportSyncResponse{}), nil
+}
+
+func (c *Controller) ListNodes(ctx context.Context, req *connect.Request[pbv1.ListNodesRequest]) (*connect.Response[pbv1.ListNodesResponse], error) {
+	if err := c.ensureConfig(); err != nil {
+		return nil, c.logAndHandleError(err, "error ensuring config")
+	}
+	conf, err := c.cloneConfig()
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error cloning config")
+	}
+
+	statuses, err := c.store.ReadNodeStatuses(ctx)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error reading node statuses")
+	}
+	statusMap := map[string]*NodeStatus{}
+	for i := range statuses {
+		statusMap[statuses[i].NodeID] = &statuses[i]
+	}
+
+	nodes := []*pbv1.NodeStatus{}
+	for _, node := range conf.Nodes {
+		nodes = append(nodes, c.nodeStatusToPB(node, statusMap[node.ID]))
+	}
+
+	return connect.NewResponse(&pbv1.ListNodesResponse{
+		Nodes: nodes,
+	}), nil
+}
+
+func (c *Controller) GetNodeStatus(ctx context.Context, req *connect.Request[pbv1.GetNodeStatusRequest]) (*connect.Response[pbv1.GetNodeStatusResponse], error) {
+	if req.Msg.NodeId == "" {
+		return nil, c.logAndHandleError(ErrNoNodeIDError, "error validating")
+	}
+
+	if err := c.ensureConfig(); err != nil {
+		return nil, c.logAndHandleError(err, "error ensuring config")
+	}
+	conf, err := c.cloneConfig()
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error cloning config")
+	}
+
+	node := c.findNode(conf, req.Msg.NodeId)
+	if node == nil {
+		return nil, c.logAndHandleError(fmt.Errorf("%w: %v", ErrNodeNotFoundError, req.Msg.NodeId), "unable to find node")
+	}
+
+	status, err := c.store.ReadNodeStatus(ctx, node.ID)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error reading node status")
+	}
+
+	return connect.NewResponse(&pbv1.GetNodeStatusResponse{
+		Node: c.nodeStatusToPB(node, status),
+	}), nil
+}
+
+func (c *Controller) findNode(conf *parsingv2.Config, nodeID string) *parsingv2.Node {
+	for _, n := range conf.Nodes {
+		if n.ID == nodeID {
+			return n
+		}
+	}
+	return nil
+}
+
+func (c *Controller) nodeStatusToPB(node *parsingv2.Node, status *NodeStatus) *pbv1.NodeStatus {
+	out := &pbv1.NodeStatus{
+		NodeId:        node.ID,
+		Hostname:      node.Hostname,
+		Channel:       node.Channel,
+		ChannelCommit: c.loadedCommit(node.Channel),
+	}
+
+	// Node has never reported in
+	if status == nil {
+		return out
+	}
+
+	out.LastSeen = timestamppb.New(status.LastSeen)
+	out.AppliedCommit = status.AppliedCommit
+	out.LastSyncResult = pbv1.SyncResult_SYNC_RESULT_FAILURE
+	if status.LastSyncSuccess {
+		out.LastSyncResult = pbv1.SyncResult_SYNC_RESULT_SUCCESS
+	}
+	for _, failure := range status.SeedFailures {
+		out.FailingSeeds = append(out.FailingSeeds, &pbv1.SeedFailure{
+			Hash:        failure.Hash,
+			DisplayName: failure.DisplayName,
+			Error:       failure.Error,
+		})
+	}
+
+	return out
+}
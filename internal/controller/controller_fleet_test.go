@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/interceptors"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/nicjohnson145/plantr/internal/token"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestController_ReportSync(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nodeID = "some-node-id"
+		now    = time.Date(2095, time.May, 15, 15, 30, 0, 0, time.UTC)
+	)
+
+	ctx := interceptors.SetTokenOnContext(context.Background(), &token.Token{
+		NodeID: nodeID,
+	})
+
+	store := NewMockStorageClient(t)
+	store.
+		EXPECT().
+		WriteNodeStatus(ctx, &NodeStatus{
+			DBNodeStatus: DBNodeStatus{
+				NodeID:          nodeID,
+				LastSeen:        now,
+				LastSyncSuccess: false,
+				AppliedCommit:   "some-commit",
+			},
+			SeedFailures: []DBSeedFailure{
+				{NodeID: nodeID, Hash: "some-hash", DisplayName: "some-seed", Error: "some-error"},
+			},
+		}).
+		Return(nil)
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			StorageClient: store,
+			NowFunc: func() time.Time {
+				return now
+			},
+		},
+		&parsingv2.Config{
+			Nodes: []*parsingv2.Node{{ID: nodeID}},
+		},
+	)
+
+	_, err := ctrl.ReportSync(ctx, connect.NewRequest(&pbv1.ReportSyncRequest{
+		Commit: "some-commit",
+		Result: pbv1.SyncResult_SYNC_RESULT_FAILURE,
+		FailingSeeds: []*pbv1.SeedFailure{
+			{Hash: "some-hash", DisplayName: "some-seed", Error: "some-error"},
+		},
+	}))
+	require.NoError(t, err)
+}
+
+func TestController_ListNodes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2095, time.May, 15, 15, 30, 0, 0, time.UTC)
+
+	store := NewMockStorageClient(t)
+	store.
+		EXPECT().
+		ReadNodeStatuses(context.Background()).
+		Return([]NodeStatus{
+			{
+				DBNodeStatus: DBNodeStatus{
+					NodeID:          "node-one",
+					LastSeen:        now,
+					LastSyncSuccess: true,
+					AppliedCommit:   "some-commit",
+				},
+			},
+		}, nil)
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{StorageClient: store},
+		&parsingv2.Config{
+			Nodes: []*parsingv2.Node{
+				{ID: "node-one", Hostname: "host-one"},
+				{ID: "node-two", Hostname: "host-two"},
+			},
+		},
+	)
+
+	got, err := ctrl.ListNodes(context.Background(), connect.NewRequest(&pbv1.ListNodesRequest{}))
+	require.NoError(t, err)
+	pbEqual(
+		t,
+		&pbv1.ListNodesResponse{
+			Nodes: []*pbv1.NodeStatus{
+				{
+					NodeId:         "node-one",
+					Hostname:       "host-one",
+					LastSeen:       timestamppb.New(now),
+					LastSyncResult: pbv1.SyncResult_SYNC_RESULT_SUCCESS,
+					AppliedCommit:  "some-commit",
+				},
+				{
+					NodeId:   "node-two",
+					Hostname: "host-two",
+				},
+			},
+		},
+		got.Msg,
+	)
+}
+
+func TestController_GetNodeStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2095, time.May, 15, 15, 30, 0, 0, time.UTC)
+
+	config := &parsingv2.Config{
+		Nodes: []*parsingv2.Node{
+			{ID: "node-one", Hostname: "host-one"},
+		},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewMockStorageClient(t)
+		store.
+			EXPECT().
+			ReadNodeStatus(context.Background(), "node-one").
+			Return(&NodeStatus{
+				DBNodeStatus: DBNodeStatus{
+					NodeID:          "node-one",
+					LastSeen:        now,
+					LastSyncSuccess: false,
+					AppliedCommit:   "some-commit",
+				},
+				SeedFailures: []DBSeedFailure{
+					{NodeID: "node-one", Hash: "some-hash", DisplayName: "some-seed", Error: "some-error"},
+				},
+			}, nil)
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{StorageClient: store}, config)
+
+		got, err := ctrl.GetNodeStatus(context.Background(), connect.NewRequest(&pbv1.GetNodeStatusRequest{NodeId: "node-one"}))
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.GetNodeStatusResponse{
+				Node: &pbv1.NodeStatus{
+					NodeId:         "node-one",
+					Hostname:       "host-one",
+					LastSeen:       timestamppb.New(now),
+					LastSyncResult: pbv1.SyncResult_SYNC_RESULT_FAILURE,
+					AppliedCommit:  "some-commit",
+					FailingSeeds: []*pbv1.SeedFailure{
+						{Hash: "some-hash", DisplayName: "some-seed", Error: "some-error"},
+					},
+				},
+			},
+			got.Msg,
+		)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{}, config)
+
+		_, err := ctrl.GetNodeStatus(context.Background(), connect.NewRequest(&pbv1.GetNodeStatusRequest{NodeId: "not-a-node"}))
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
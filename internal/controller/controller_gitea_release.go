@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/carlmjohnson/requests"
+)
+
+// listGiteaReleases fetches releases newest first. Gitea doesn't say if there's another page, so pages are fetched
+// until a short one, up to releaseListMaxPages
+func (c *Controller) listGiteaReleases(host ReleaseHost, repo string) ([]githubReleaseListing, error) {
+	ctx, cancel := c.releaseLookupContext(host)
+	defer cancel()
+
+	out := []githubReleaseListing{}
+	for page := 1; page <= releaseListMaxPages; page++ {
+		var resp []githubReleaseListing
+		builder, err := host.authorize(
+			requests.
+				URL(host.ApiURL).
+				Pathf("repos/%v/releases", repo).
+				Param("limit", strconv.Itoa(giteaPageSize)).
+				Param("page", strconv.Itoa(page)).
+				Client(c.githubClient).
+				ToJSON(&resp),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := builder.Fetch(ctx); err != nil {
+			return nil, fmt.Errorf("error listing releases: %w", err)
+		}
+
+		out = append(out, resp...)
+		if len(resp) < giteaPageSize {
+			break
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGiteaRelease(t *testing.T) {
+	t.Parallel()
+
+	const (
+		apiURL   = "https://gitea.example.com/api/v1"
+		assetURL = "https://gitea.example.com/some/tool/releases/download/v1.0.0/tool-linux-amd64"
+	)
+
+	fullPage := []map[string]any{}
+	for i := 0; i < giteaPageSize; i++ {
+		fullPage = append(fullPage, map[string]any{"tag_name": fmt.Sprintf("v1.%v.0", giteaPageSize-i)})
+	}
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		apiURL+"/repos/some/tool/releases/tags/v1.0.0",
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "token some-gitea-token", req.Header.Get("Authorization"))
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"assets": []map[string]any{
+					{"name": "tool-linux-amd64", "browser_download_url": assetURL},
+				},
+			})
+		},
+	)
+	mockTransport.RegisterResponderWithQuery(
+		http.MethodGet,
+		apiURL+"/repos/some/tool/releases",
+		map[string]string{"limit": "50", "page": "1"},
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, fullPage),
+	)
+	mockTransport.RegisterResponderWithQuery(
+		http.MethodGet,
+		apiURL+"/repos/some/tool/releases",
+		map[string]string{"limit": "50", "page": "2"},
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{
+			{"tag_name": "v1.0.0"},
+		}),
+	)
+
+	storage := NewMockStorageClient(t)
+	storage.EXPECT().ReadGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	storage.EXPECT().WriteGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			StorageClient: storage,
+			HttpClient:    &http.Client{Transport: mockTransport},
+			ReleaseHosts: map[string]ReleaseHost{
+				"forge": {ApiURL: apiURL, Token: "some-gitea-token"},
+			},
+		},
+		nil,
+	)
+
+	t.Run("render", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{Repo: "some/tool", Tag: "v1.0.0", Provider: "gitea", Host: "forge"},
+			&parsingv2.Node{OS: "linux", Arch: "amd64"},
+		)
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.Seed{
+				Element: &pbv1.Seed_GithubRelease{
+					GithubRelease: &pbv1.GithubRelease{
+						DownloadUrl: assetURL,
+						Authentication: &pbv1.GithubRelease_Authentication{
+							BearerAuth: "token some-gitea-token",
+						},
+					},
+				},
+			},
+			got,
+		)
+	})
+
+	t.Run("list follows pages", func(t *testing.T) {
+		t.Parallel()
+
+		host, err := ctrl.releaseHost(&parsingv2.GithubRelease{Provider: "gitea", Host: "forge"})
+		require.NoError(t, err)
+		releases, err := ctrl.listReleases(context.Background(), host, "some/tool")
+		require.NoError(t, err)
+		require.Len(t, releases, giteaPageSize+1)
+		require.Equal(t, "v1.0.0", releases[giteaPageSize].TagName)
+	})
+}
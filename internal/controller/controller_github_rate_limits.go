@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *Controller) GetGithubRateLimits(ctx context.Context, req *connect.Request[pbv1.GetGithubRateLimitsRequest]) (*connect.Response[pbv1.GetGithubRateLimitsResponse], error) {
+	out := []*pbv1.GithubRateLimit{}
+	for _, limit := range c.githubTransport.RateLimits() {
+		out = append(out, &pbv1.GithubRateLimit{
+			Host:      limit.Host,
+			Resource:  limit.Resource,
+			Limit:     int64(limit.Limit),
+			Remaining: int64(limit.Remaining),
+			ResetsAt:  timestamppb.New(limit.Reset),
+			UpdatedAt: timestamppb.New(limit.Updated),
+		})
+	}
+
+	return connect.NewResponse(&pbv1.GetGithubRateLimitsResponse{
+		RateLimits: out,
+	}), nil
+}
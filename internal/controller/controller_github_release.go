@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/carlmjohnson/requests"
 	"github.com/nicjohnson145/hlp"
@@ -17,6 +18,10 @@ var (
 	ErrUnableToAutoDetectAssetError = errors.New("unable to auto-detect asset")
 )
 
+const (
+	defaultReleaseLookupTimeout = 30 * time.Second
+)
+
 type githubTagResponse struct {
 	Assets []githubAsset `json:"assets"`
 }
@@ -32,12 +37,17 @@ func basicAuth(user string, pass string) string {
 }
 
 func (c *Controller) renderSeed_githubRelease(ctx context.Context, release *parsingv2.GithubRelease, node *parsingv2.Node) (*pbv1.Seed, error) {
+	host, err := c.releaseHost(release)
+	if err != nil {
+		return nil, err
+	}
+
 	c.log.Trace().Msg("reading asset cache")
 	hash, err := c.hashFunc(&parsingv2.Seed{Element: release}, node)
 	if err != nil {
 		return nil, err
 	}
-	assertUrl, err := c.store.ReadGithubReleaseAsset(ctx, &DBGithubRelease{
+	cached, err := c.store.ReadGithubReleaseAsset(ctx, &DBGithubRelease{
 		Hash: hash,
 		OS:   node.OS,
 		Arch: node.Arch,
@@ -45,53 +55,63 @@ func (c *Controller) renderSeed_githubRelease(ctx context.Context, release *pars
 	if err != nil {
 		return nil, fmt.Errorf("error reading asset cache: %w", err)
 	}
-	if assertUrl == "" {
+	if cached == nil {
 		c.log.Trace().Msg("cache miss, attempting to get release asset from GitHub")
-		var resp githubTagResponse
-		builder := requests.
-			URL("https://api.github.com").
-			Pathf("repos/%v/releases/tags/%v", release.Repo, release.Tag).
-			Client(c.httpClient).
-			ToJSON(&resp)
-		if c.githubReleaseToken == "" {
-			c.log.Warn().Msg("making un-authenticated request to github API, this will likely result in being very quickly rate limited")
-		} else {
-			builder = builder.Header("Authorization", basicAuth("__token__", c.githubReleaseToken))
-		}
-
-		if err := builder.Fetch(context.Background()); err != nil {
-			return nil, fmt.Errorf("error getting release assets: %w", err)
+		assets, err := c.getReleaseAssets(host, release.Repo, release.EffectiveTag())
+		if err != nil {
+			return nil, err
 		}
 
-		asset, err := c.getAssetForOSArch(release, node, resp.Assets)
+		asset, err := c.getAssetForOSArch(release, node, assets)
 		if err != nil {
 			return nil, fmt.Errorf("error filtering release assets: %w", err)
 		}
 
-		assertUrl = asset.DownloadUrl
-
-		cachedRelease := &DBGithubRelease{
+		cached = &DBGithubRelease{
 			Hash:        hash,
 			OS:          node.OS,
 			Arch:        node.Arch,
-			DownloadURL: assertUrl,
+			DownloadURL: asset.DownloadUrl,
 		}
-		if err := c.store.WriteGithubReleaseAsset(ctx, cachedRelease); err != nil {
+
+		if release.VerifyChecksums && release.GetSha256(node) == nil {
+			c.log.Trace().Msg("looking up asset checksum from release manifest")
+			cached.Sha256, err = c.getAssetChecksum(host, asset, assets)
+			if err != nil {
+				return nil, fmt.Errorf("error getting asset checksum: %w", err)
+			}
+		}
+
+		if err := c.store.WriteGithubReleaseAsset(ctx, cached); err != nil {
 			return nil, fmt.Errorf("error writing result to cache: %w", err)
 		}
 	}
 
 	outRelease := &pbv1.GithubRelease{
-		DownloadUrl:          assertUrl,
+		DownloadUrl:          cached.DownloadURL,
 		DestinationDirectory: node.BinDir,
 		NameOverride:         release.NameOverride,
 		ArchiveRelease:       release.ArchiveRelease,
 		BinaryRegex:          release.BinaryRegex,
+		Sha256:               release.GetSha256(node),
+	}
+	if outRelease.Sha256 == nil && cached.Sha256 != "" {
+		outRelease.Sha256 = &cached.Sha256
+	}
+
+	outRelease.Signature, err = renderSignature(release.Signature, cached.DownloadURL)
+	if err != nil {
+		return nil, err
 	}
 
-	if c.githubReleaseToken != "" {
+	// Assets can link anywhere, only hand the agent the host's credential for downloads from the host itself
+	token, err := host.token()
+	if err != nil {
+		return nil, err
+	}
+	if token != "" && host.servesURL(cached.DownloadURL) {
 		outRelease.Authentication = &pbv1.GithubRelease_Authentication{
-			BearerAuth: fmt.Sprintf("Bearer %v", c.githubReleaseToken),
+			BearerAuth: host.downloadAuthorization(token),
 		}
 	}
 
@@ -102,6 +122,57 @@ func (c *Controller) renderSeed_githubRelease(ctx context.Context, release *pars
 	}, nil
 }
 
+// getReleaseAssets fetches the assets for a release from the host. Concurrent calls for the same repo@tag share a
+// single request, so several nodes syncing at once don't each hit the API
+func (c *Controller) getReleaseAssets(host ReleaseHost, repo string, tag string) ([]githubAsset, error) {
+	assets, err, _ := c.releaseGroup.Do(host.Provider+":"+host.ApiURL+":"+repo+"@"+tag, func() (any, error) {
+		if host.anonymous() {
+			c.log.Warn().Msg("making un-authenticated request to release API, this will likely result in being very quickly rate limited")
+		}
+
+		// Gitea's release API mirrors GitHub's
+		if host.Provider == parsingv2.ReleaseProviderGitlab {
+			return c.getGitlabReleaseAssets(host, repo, tag)
+		}
+		return c.getGithubReleaseAssets(host, repo, tag)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assets.([]githubAsset), nil
+}
+
+// releaseLookupContext bounds a release API request by the release lookup timeout. The requests are made inside
+// releaseGroup, so it isn't the callers context, other callers may be waiting on the same request. Without the
+// timeout a hung request would block every one of them indefinitely
+func (c *Controller) releaseLookupContext(host ReleaseHost) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.releaseLookupTimeout)
+	return host.withCredential(ctx), cancel
+}
+
+func (c *Controller) getGithubReleaseAssets(host ReleaseHost, repo string, tag string) ([]githubAsset, error) {
+	var resp githubTagResponse
+	builder, err := host.authorize(
+		requests.
+			URL(host.ApiURL).
+			Pathf("repos/%v/releases/tags/%v", repo, tag).
+			Client(c.githubClient).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.releaseLookupContext(host)
+	defer cancel()
+	if err := builder.Fetch(ctx); err != nil {
+		return nil, fmt.Errorf("error getting release assets: %w", err)
+	}
+
+	return resp.Assets, nil
+}
+
 var (
 	regexMusl     = regexp.MustCompile(`(?i)musl`)
 	regexChecksum = regexp.MustCompile(`(?i)(\b|_|-)(.sha256|.sha256sum|.sig)$`)
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/carlmjohnson/requests"
+)
+
+var (
+	ErrNoChecksumManifestError = errors.New("no checksum manifest found")
+	ErrChecksumNotFoundError   = errors.New("checksum not found in manifest")
+)
+
+var (
+	// Release wide manifests, i.e checksums.txt, SHA256SUMS, foo_1.2.3_checksums.txt
+	regexChecksumManifest = regexp.MustCompile(`(?i)(checksums?|sha256sums?)(\.txt)?$`)
+	// BSD style "SHA256 (file) = digest" lines, as produced by `shasum --tag`
+	regexBSDChecksumLine = regexp.MustCompile(`^SHA256 \((.+)\) = ([0-9a-fA-F]{64})$`)
+	regexSha256Hex       = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+)
+
+// getAssetChecksum locates the checksum manifest for asset among the release assets, and extracts the sha256 for it
+func (c *Controller) getAssetChecksum(host ReleaseHost, asset *githubAsset, assets []githubAsset) (string, error) {
+	manifest := findChecksumManifest(asset, assets)
+	if manifest == nil {
+		return "", fmt.Errorf("%w for %v", ErrNoChecksumManifestError, asset.Name)
+	}
+
+	c.log.Trace().Msgf("using checksum manifest %v", manifest.Name)
+	content, err := c.getChecksumManifest(host, manifest.DownloadUrl)
+	if err != nil {
+		return "", err
+	}
+
+	return parseChecksumManifest(content, asset.Name)
+}
+
+// findChecksumManifest prefers a manifest dedicated to the asset (<asset>.sha256), falling back to a release wide one.
+// Manifests dedicated to other assets are never taken as release wide, a lone digest in one would be read as the
+// asset's own
+func findChecksumManifest(asset *githubAsset, assets []githubAsset) *githubAsset {
+	manifestSuffixes := []string{".sha256", ".sha256sum"}
+	for _, suffix := range manifestSuffixes {
+		for i := range assets {
+			if strings.EqualFold(assets[i].Name, asset.Name+suffix) {
+				return &assets[i]
+			}
+		}
+	}
+
+	names := map[string]struct{}{}
+	for _, a := range assets {
+		names[strings.ToLower(a.Name)] = struct{}{}
+	}
+	dedicated := func(name string) bool {
+		for _, suffix := range manifestSuffixes {
+			if stem, ok := strings.CutSuffix(strings.ToLower(name), suffix); ok {
+				if _, ok := names[stem]; ok {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for i := range assets {
+		if regexChecksumManifest.MatchString(assets[i].Name) && !dedicated(assets[i].Name) {
+			return &assets[i]
+		}
+	}
+
+	return nil
+}
+
+// getChecksumManifest downloads the manifest content. Like release lookups, concurrent calls for the same manifest
+// share a single request
+func (c *Controller) getChecksumManifest(host ReleaseHost, url string) (string, error) {
+	content, err, _ := c.releaseGroup.Do("manifest:"+url, func() (any, error) {
+		var content string
+		builder := requests.
+			URL(url).
+			Client(c.githubClient).
+			ToString(&content)
+		// Same as downloads, the manifest may be linked from somewhere other than the host
+		if host.servesURL(url) {
+			var err error
+			builder, err = host.authorize(builder)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ctx, cancel := c.releaseLookupContext(host)
+		defer cancel()
+		if err := builder.Fetch(ctx); err != nil {
+			return nil, fmt.Errorf("error getting checksum manifest: %w", err)
+		}
+
+		return content, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return content.(string), nil
+}
+
+// parseChecksumManifest extracts the sha256 for name from either sha256sum or BSD style output. A manifest holding a
+// single bare digest is taken to be for name
+func parseChecksumManifest(content string, name string) (string, error) {
+	lines := []string{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) == 1 && regexSha256Hex.MatchString(lines[0]) {
+		return strings.ToLower(lines[0]), nil
+	}
+
+	for _, line := range lines {
+		if match := regexBSDChecksumLine.FindStringSubmatch(line); match != nil {
+			if path.Base(match[1]) == name {
+				return strings.ToLower(match[2]), nil
+			}
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !regexSha256Hex.MatchString(fields[0]) {
+			continue
+		}
+		// sha256sum marks binary mode with a leading '*', and some releases list paths rather than bare names
+		if path.Base(strings.TrimPrefix(fields[len(fields)-1], "*")) == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w for %v", ErrChecksumNotFoundError, name)
+}
@@ -0,0 +1,237 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/nicjohnson145/hlp"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	someSha256  = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	otherSha256 = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+)
+
+func TestParseChecksumManifest(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		name    string
+		content string
+		want    string
+		err     error
+	}{
+		{
+			name:    "sha256sum",
+			content: otherSha256 + "  other-linux-amd64.tar.gz\n" + someSha256 + "  some-linux-amd64.tar.gz\n",
+			want:    someSha256,
+		},
+		{
+			name:    "binary mode with path",
+			content: someSha256 + " *dist/some-linux-amd64.tar.gz\n",
+			want:    someSha256,
+		},
+		{
+			name:    "bsd style",
+			content: "SHA256 (other-linux-amd64.tar.gz) = " + otherSha256 + "\nSHA256 (some-linux-amd64.tar.gz) = " + someSha256 + "\n",
+			want:    someSha256,
+		},
+		{
+			name:    "bare digest",
+			content: "  " + someSha256 + "\n",
+			want:    someSha256,
+		},
+		{
+			name:    "uppercase",
+			content: "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855  some-linux-amd64.tar.gz",
+			want:    someSha256,
+		},
+		{
+			name:    "missing",
+			content: otherSha256 + "  other-linux-amd64.tar.gz\n",
+			err:     ErrChecksumNotFoundError,
+		},
+	}
+	for _, tc := range testData {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseChecksumManifest(tc.content, "some-linux-amd64.tar.gz")
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFindChecksumManifest(t *testing.T) {
+	t.Parallel()
+
+	asset := &githubAsset{Name: "some-linux-amd64.tar.gz"}
+
+	testData := []struct {
+		name   string
+		assets []string
+		want   string
+	}{
+		{
+			name:   "dedicated manifest wins",
+			assets: []string{"some-linux-amd64.tar.gz", "checksums.txt", "some-linux-amd64.tar.gz.sha256"},
+			want:   "some-linux-amd64.tar.gz.sha256",
+		},
+		{
+			name:   "release wide",
+			assets: []string{"some-linux-amd64.tar.gz", "some_1.0.0_checksums.txt"},
+			want:   "some_1.0.0_checksums.txt",
+		},
+		{
+			name:   "SHA256SUMS",
+			assets: []string{"some-linux-amd64.tar.gz", "SHA256SUMS"},
+			want:   "SHA256SUMS",
+		},
+		{
+			name:   "none",
+			assets: []string{"some-linux-amd64.tar.gz", "other-linux-amd64.tar.gz.sha256"},
+			want:   "",
+		},
+		{
+			name:   "sibling asset sha256sum isn't release wide",
+			assets: []string{"some-linux-amd64.tar.gz", "other_linux_arm64", "other_linux_arm64.sha256sum"},
+			want:   "",
+		},
+		{
+			name:   "sibling asset sha256sum is skipped for a release wide one",
+			assets: []string{"some-linux-amd64.tar.gz", "other_linux_arm64", "other_linux_arm64.sha256sum", "SHA256SUMS"},
+			want:   "SHA256SUMS",
+		},
+	}
+	for _, tc := range testData {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assets := []githubAsset{}
+			for _, name := range tc.assets {
+				assets = append(assets, githubAsset{Name: name})
+			}
+
+			got := findChecksumManifest(asset, assets)
+			if tc.want == "" {
+				require.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			require.Equal(t, tc.want, got.Name)
+		})
+	}
+}
+
+func TestGithubRelease_VerifyChecksums(t *testing.T) {
+	t.Parallel()
+
+	const (
+		releaseURL  = "https://api.github.com/repos/some/repo/releases/tags/v1.0.0"
+		assetURL    = "https://github.com/some/repo/releases/download/v1.0.0/some-linux-amd64.tar.gz"
+		manifestURL = "https://github.com/some/repo/releases/download/v1.0.0/checksums.txt"
+	)
+
+	node := &parsingv2.Node{OS: "linux", Arch: "amd64"}
+
+	newController := func(t *testing.T, written *DBGithubRelease) (*Controller, *httpmock.MockTransport) {
+		t.Helper()
+
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			releaseURL,
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+				"assets": []map[string]any{
+					{"name": "some-linux-amd64.tar.gz", "browser_download_url": assetURL},
+					{"name": "checksums.txt", "browser_download_url": manifestURL},
+				},
+			}),
+		)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			manifestURL,
+			httpmock.NewStringResponder(http.StatusOK, someSha256+"  some-linux-amd64.tar.gz\n"),
+		)
+
+		storage := NewMockStorageClient(t)
+		storage.EXPECT().ReadGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil, nil)
+		storage.EXPECT().WriteGithubReleaseAsset(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, asset *DBGithubRelease) error {
+			*written = *asset
+			return nil
+		})
+
+		ctrl := newControllerWithConfig(
+			t,
+			ControllerConfig{
+				StorageClient: storage,
+				HttpClient:    &http.Client{Transport: mockTransport},
+			},
+			nil,
+		)
+		return ctrl, mockTransport
+	}
+
+	t.Run("digest from manifest", func(t *testing.T) {
+		t.Parallel()
+
+		written := &DBGithubRelease{}
+		ctrl, mockTransport := newController(t, written)
+
+		got, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{Repo: "some/repo", Tag: "v1.0.0", VerifyChecksums: true},
+			node,
+		)
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.Seed{
+				Element: &pbv1.Seed_GithubRelease{
+					GithubRelease: &pbv1.GithubRelease{
+						DownloadUrl: assetURL,
+						Sha256:      hlp.Ptr(someSha256),
+					},
+				},
+			},
+			got,
+		)
+		require.Equal(t, someSha256, written.Sha256)
+		require.Equal(t, 1, mockTransport.GetCallCountInfo()["GET "+manifestURL])
+	})
+
+	t.Run("explicit sha256 wins", func(t *testing.T) {
+		t.Parallel()
+
+		written := &DBGithubRelease{}
+		ctrl, mockTransport := newController(t, written)
+
+		got, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{
+				Repo:            "some/repo",
+				Tag:             "v1.0.0",
+				VerifyChecksums: true,
+				Sha256: map[string]map[string]string{
+					"linux": {"amd64": otherSha256},
+				},
+			},
+			node,
+		)
+		require.NoError(t, err)
+		require.Equal(t, otherSha256, got.GetGithubRelease().GetSha256())
+		require.Empty(t, written.Sha256)
+		require.Equal(t, 0, mockTransport.GetCallCountInfo()["GET "+manifestURL])
+	})
+}
@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/carlmjohnson/requests"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+)
+
+var (
+	ErrNoMatchingReleaseError = errors.New("no matching release")
+)
+
+const (
+	// bounds how far back floating tags look on forges that paginate release listings
+	releaseListMaxPages = 3
+	releaseListPageSize = 100
+	// Gitea caps page sizes at 50 by default
+	giteaPageSize = 50
+)
+
+type githubReleaseListing struct {
+	TagName    string `json:"tag_name"`
+	Draft      bool   `json:"draft"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+type resolvedReleaseTag struct {
+	tag     string
+	expires time.Time
+}
+
+// resolveReleaseTags fills in the concrete tag for any github releases with a floating tag, so the display name and
+// hash reflect what will actually be installed and agents upgrade when a new release lands. Seeds are modified in
+// place, so this must only be called on a cloned config
+func (c *Controller) resolveReleaseTags(ctx context.Context, seeds []*parsingv2.Seed) error {
+	for _, seed := range seeds {
+		release, ok := seed.Element.(*parsingv2.GithubRelease)
+		if !ok || !release.Floating() || release.ResolvedTag != "" {
+			continue
+		}
+
+		tag, err := c.resolveReleaseTag(ctx, release)
+		if err != nil {
+			return fmt.Errorf("error resolving tag %q for %v: %w", release.Tag, release.Repo, err)
+		}
+		c.log.Trace().Msgf("resolved %v@%v to %v", release.Repo, release.Tag, tag)
+		release.ResolvedTag = tag
+	}
+
+	return nil
+}
+
+func (c *Controller) resolveReleaseTag(ctx context.Context, release *parsingv2.GithubRelease) (string, error) {
+	host, err := c.releaseHost(release)
+	if err != nil {
+		return "", err
+	}
+
+	key := fmt.Sprintf("%v:%v:%v@%v:%v", host.Provider, host.ApiURL, release.Repo, release.Tag, release.IncludePrereleases)
+
+	c.releaseTagMu.Lock()
+	cached, ok := c.releaseTags[key]
+	c.releaseTagMu.Unlock()
+	if ok && c.now().Before(cached.expires) {
+		return cached.tag, nil
+	}
+
+	releases, err := c.listReleases(ctx, host, release.Repo)
+	if err != nil {
+		return "", err
+	}
+
+	tag, err := selectReleaseTag(releases, release.Tag, release.IncludePrereleases)
+	if err != nil {
+		return "", err
+	}
+
+	if c.releaseTagTTL > 0 {
+		c.releaseTagMu.Lock()
+		// Drop anything expired while we're here, so tags for repos no longer in the config don't pile up
+		now := c.now()
+		for k, v := range c.releaseTags {
+			if !now.Before(v.expires) {
+				delete(c.releaseTags, k)
+			}
+		}
+		c.releaseTags[key] = resolvedReleaseTag{tag: tag, expires: now.Add(c.releaseTagTTL)}
+		c.releaseTagMu.Unlock()
+	}
+
+	return tag, nil
+}
+
+// listReleases fetches the most recent releases for the repo, newest first. Like release lookups, concurrent calls for
+// the same repo share a single request. A caller whose ctx ends stops waiting, the request carries on for the others
+func (c *Controller) listReleases(ctx context.Context, host ReleaseHost, repo string) ([]githubReleaseListing, error) {
+	ch := c.releaseGroup.DoChan("releases:"+host.Provider+":"+host.ApiURL+":"+repo, func() (any, error) {
+		switch host.Provider {
+		case parsingv2.ReleaseProviderGitlab:
+			return c.listGitlabReleases(host, repo)
+		case parsingv2.ReleaseProviderGitea:
+			return c.listGiteaReleases(host, repo)
+		default:
+			return c.listGithubReleases(host, repo)
+		}
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error listing releases: %w", ctx.Err())
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]githubReleaseListing), nil
+	}
+}
+
+// listGithubReleases fetches releases newest first, following the Link header up to releaseListMaxPages
+func (c *Controller) listGithubReleases(host ReleaseHost, repo string) ([]githubReleaseListing, error) {
+	ctx, cancel := c.releaseLookupContext(host)
+	defer cancel()
+
+	out := []githubReleaseListing{}
+	next := ""
+	for i := 0; i < releaseListMaxPages; i++ {
+		builder := requests.
+			URL(host.ApiURL).
+			Pathf("repos/%v/releases", repo).
+			Param("per_page", strconv.Itoa(releaseListPageSize))
+		if next != "" {
+			builder = requests.URL(next)
+		}
+
+		var resp []githubReleaseListing
+		headers := http.Header{}
+		builder, err := host.authorize(builder.Client(c.githubClient).CopyHeaders(headers).ToJSON(&resp))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := builder.Fetch(ctx); err != nil {
+			return nil, fmt.Errorf("error listing releases: %w", err)
+		}
+
+		out = append(out, resp...)
+		next = githubNextPage(headers.Get("Link"))
+		if next == "" {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+// githubNextPage extracts the rel="next" url from a Link header, empty if there isn't one
+func githubNextPage(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		target, params, ok := strings.Cut(strings.TrimSpace(part), ";")
+		if !ok || !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(target), "<>")
+	}
+	return ""
+}
+
+// selectReleaseTag picks the highest semver release satisfying spec, which is either "latest" or a semver constraint.
+// Drafts are never considered, and prereleases only when asked for. For "latest", a repo whose tags aren't semver falls
+// back to the newest eligible release
+func selectReleaseTag(releases []githubReleaseListing, spec string, includePrereleases bool) (string, error) {
+	var constraint *semver.Constraints
+	if spec != parsingv2.TagLatest {
+		c, err := semver.NewConstraint(spec)
+		if err != nil {
+			return "", fmt.Errorf("error parsing constraint: %w", err)
+		}
+		constraint = c
+	}
+
+	var best *semver.Version
+	bestTag := ""
+	newest := ""
+	for _, release := range releases {
+		if release.Draft {
+			continue
+		}
+
+		version, err := semver.NewVersion(release.TagName)
+		isPrerelease := release.Prerelease || (err == nil && version.Prerelease() != "")
+		if isPrerelease && !includePrereleases {
+			continue
+		}
+		if newest == "" {
+			newest = release.TagName
+		}
+		if err != nil {
+			continue
+		}
+
+		if constraint != nil {
+			// Constraints never match prereleases on their own, so check the release version it leads up to instead
+			check := version
+			if includePrereleases && version.Prerelease() != "" {
+				core, err := version.SetPrerelease("")
+				if err != nil {
+					continue
+				}
+				check = &core
+			}
+			if !constraint.Check(check) {
+				continue
+			}
+		}
+
+		if best == nil || version.GreaterThan(best) {
+			best = version
+			bestTag = release.TagName
+		}
+	}
+
+	if bestTag != "" {
+		return bestTag, nil
+	}
+	if constraint == nil && newest != "" {
+		return newest, nil
+	}
+
+	return "", fmt.Errorf("%w for %q", ErrNoMatchingReleaseError, spec)
+}
@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectReleaseTag(t *testing.T) {
+	t.Parallel()
+
+	releases := []githubReleaseListing{
+		{TagName: "v3.0.0-rc1", Prerelease: true},
+		{TagName: "v2.1.0"},
+		{TagName: "v2.0.1"},
+		{TagName: "v2.0.0"},
+		{TagName: "v1.5.0", Draft: true},
+		{TagName: "v1.4.7"},
+		{TagName: "v1.4.2"},
+		{TagName: "v1.3.0"},
+	}
+
+	testData := []struct {
+		name        string
+		releases    []githubReleaseListing
+		spec        string
+		prereleases bool
+		want        string
+		err         error
+	}{
+		{
+			name: "latest",
+			spec: "latest",
+			want: "v2.1.0",
+		},
+		{
+			name:        "latest with prereleases",
+			spec:        "latest",
+			prereleases: true,
+			want:        "v3.0.0-rc1",
+		},
+		{
+			name: "tilde",
+			spec: "~1.4",
+			want: "v1.4.7",
+		},
+		{
+			name: "range",
+			spec: ">=2.0 <2.1",
+			want: "v2.0.1",
+		},
+		{
+			name:        "range matching prerelease",
+			spec:        ">=2.0 <4",
+			prereleases: true,
+			want:        "v3.0.0-rc1",
+		},
+		{
+			name: "drafts skipped",
+			spec: "~1.5",
+			err:  ErrNoMatchingReleaseError,
+		},
+		{
+			name: "latest out of order",
+			releases: []githubReleaseListing{
+				{TagName: "v1.2.4"},
+				{TagName: "v2.0.0"},
+				{TagName: "v1.9.0"},
+			},
+			spec: "latest",
+			want: "v2.0.0",
+		},
+		{
+			name: "latest non-semver",
+			releases: []githubReleaseListing{
+				{TagName: "nightly-2024-10-01", Prerelease: true},
+				{TagName: "release-2024-09-01"},
+				{TagName: "release-2024-08-01"},
+			},
+			spec: "latest",
+			want: "release-2024-09-01",
+		},
+		{
+			name: "no releases",
+			releases: []githubReleaseListing{
+				{TagName: "v1.0.0-beta", Prerelease: true},
+			},
+			spec: "latest",
+			err:  ErrNoMatchingReleaseError,
+		},
+	}
+	for _, tc := range testData {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := releases
+			if tc.releases != nil {
+				input = tc.releases
+			}
+
+			got, err := selectReleaseTag(input, tc.spec, tc.prereleases)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveReleaseTags(t *testing.T) {
+	t.Parallel()
+
+	const releasesURL = "https://api.github.com/repos/some/repo/releases"
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		releasesURL,
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{
+			{"tag_name": "v1.1.0"},
+			{"tag_name": "v1.0.0"},
+		}).Then(httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{
+			{"tag_name": "v1.2.0"},
+			{"tag_name": "v1.1.0"},
+			{"tag_name": "v1.0.0"},
+		})),
+	)
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			HttpClient:    &http.Client{Transport: mockTransport},
+			ReleaseTagTTL: 10 * time.Minute,
+			NowFunc: func() time.Time {
+				return now
+			},
+		},
+		nil,
+	)
+
+	resolve := func(t *testing.T) (*parsingv2.GithubRelease, *parsingv2.GithubRelease) {
+		t.Helper()
+
+		floating := &parsingv2.GithubRelease{Repo: "some/repo", Tag: "latest"}
+		pinned := &parsingv2.GithubRelease{Repo: "some/repo", Tag: "v1.0.0"}
+		require.NoError(t, ctrl.resolveReleaseTags(context.Background(), []*parsingv2.Seed{{Element: floating}, {Element: pinned}}))
+		return floating, pinned
+	}
+
+	floating, pinned := resolve(t)
+	require.Equal(t, "v1.1.0", floating.ResolvedTag)
+	require.Empty(t, pinned.ResolvedTag)
+
+	displayName, err := floating.DisplayName(nil)
+	require.NoError(t, err)
+	require.Equal(t, "some/repo@v1.1.0", displayName)
+
+	// Within the TTL, served from cache
+	now = now.Add(5 * time.Minute)
+	floating, _ = resolve(t)
+	require.Equal(t, "v1.1.0", floating.ResolvedTag)
+	require.Equal(t, 1, mockTransport.GetCallCountInfo()["GET "+releasesURL])
+
+	// Expired, picks up the new release
+	ctrl.releaseTags["some-removed-repo"] = resolvedReleaseTag{tag: "v0.1.0", expires: now.Add(time.Minute)}
+	now = now.Add(10 * time.Minute)
+	floating, _ = resolve(t)
+	require.Equal(t, "v1.2.0", floating.ResolvedTag)
+	require.Equal(t, 2, mockTransport.GetCallCountInfo()["GET "+releasesURL])
+
+	// Expired entries are dropped rather than kept forever
+	require.Len(t, ctrl.releaseTags, 1)
+}
+
+func TestListGithubReleases(t *testing.T) {
+	t.Parallel()
+
+	const releasesURL = "https://api.github.com/repos/some/repo/releases"
+
+	page := func(n int) string {
+		return fmt.Sprintf("%v?per_page=100&page=%v", releasesURL, n)
+	}
+	link := func(n int) http.Header {
+		return http.Header{"Link": []string{fmt.Sprintf(`<%v>; rel="next", <%v>; rel="last"`, page(n), page(5))}}
+	}
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		releasesURL,
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{{"tag_name": "v3.0.0"}}).HeaderSet(link(2)),
+	)
+	for n := 2; n <= 4; n++ {
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			page(n),
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{{"tag_name": fmt.Sprintf("v%v.0.0", 3-n+1)}}).HeaderSet(link(n+1)),
+		)
+	}
+
+	ctrl := newControllerWithConfig(t, ControllerConfig{HttpClient: &http.Client{Transport: mockTransport}}, nil)
+
+	releases, err := ctrl.listGithubReleases(ctrl.releaseHosts[""], "some/repo")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]githubReleaseListing{{TagName: "v3.0.0"}, {TagName: "v2.0.0"}, {TagName: "v1.0.0"}},
+		releases,
+	)
+	// Stops at the page cap, even though there are more
+	require.Equal(t, releaseListMaxPages, mockTransport.GetTotalCallCount())
+}
+
+func TestGithubNextPage(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(
+		t,
+		"https://api.github.com/repositories/1/releases?page=2",
+		githubNextPage(`<https://api.github.com/repositories/1/releases?page=2>; rel="next", <https://api.github.com/repositories/1/releases?page=9>; rel="last"`),
+	)
+	require.Empty(t, githubNextPage(`<https://api.github.com/repositories/1/releases?page=1>; rel="prev"`))
+	require.Empty(t, githubNextPage(""))
+}
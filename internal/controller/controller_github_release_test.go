@@ -9,12 +9,15 @@ import (
 	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/nicjohnson145/hlp/set"
 	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
 	"github.com/nicjohnson145/plantr/internal/parsingv2"
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -220,3 +223,143 @@ func TestGithubRelease_AssetUrlCaching(t *testing.T) {
 		)
 	})
 }
+
+func TestGithubRelease_ConcurrentRendering(t *testing.T) {
+	t.Parallel()
+
+	releaseURL := func(repo string) string {
+		return fmt.Sprintf("https://api.github.com/repos/%v/releases/tags/v1.0.0", repo)
+	}
+
+	newController := func(t *testing.T, delays map[string]time.Duration) (*Controller, *httpmock.MockTransport) {
+		t.Helper()
+
+		mockTransport := httpmock.NewMockTransport()
+		for repo, delay := range delays {
+			mockTransport.RegisterResponder(
+				http.MethodGet,
+				releaseURL(repo),
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+					"assets": []map[string]any{
+						{"name": repo + "-linux-amd64", "browser_download_url": repo + "-linux-amd64-url"},
+						{"name": repo + "-darwin-arm64", "browser_download_url": repo + "-darwin-arm64-url"},
+					},
+				}).Delay(delay),
+			)
+		}
+
+		storage := NewMockStorageClient(t)
+		storage.EXPECT().ReadGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+		storage.EXPECT().WriteGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+		ctrl := newControllerWithConfig(
+			t,
+			ControllerConfig{
+				StorageClient:     storage,
+				VaultClient:       NewNoopVault(NoopVaultConfig{}),
+				HttpClient:        &http.Client{Transport: mockTransport},
+				RenderConcurrency: 4,
+			},
+			nil,
+		)
+		return ctrl, mockTransport
+	}
+
+	t.Run("output keeps config order", func(t *testing.T) {
+		t.Parallel()
+
+		// Earlier seeds are slower, so they finish last
+		ctrl, _ := newController(t, map[string]time.Duration{
+			"slow":   60 * time.Millisecond,
+			"medium": 30 * time.Millisecond,
+			"fast":   0,
+		})
+
+		node := &parsingv2.Node{OS: "linux", Arch: "amd64"}
+		got, err := ctrl.renderSeeds(
+			context.Background(),
+			node,
+			[]*parsingv2.Seed{
+				{Element: &parsingv2.GithubRelease{Repo: "slow", Tag: "v1.0.0"}},
+				{Element: &parsingv2.Golang{Version: "1.23.1"}},
+				{Element: &parsingv2.GithubRelease{Repo: "medium", Tag: "v1.0.0"}},
+				{Element: &parsingv2.GithubRelease{Repo: "fast", Tag: "v1.0.0"}},
+			},
+			set.New[string](),
+		)
+		require.NoError(t, err)
+
+		names := []string{}
+		for _, seed := range got {
+			names = append(names, seed.Metadata.DisplayName)
+		}
+		require.Equal(t, []string{"slow@v1.0.0", "go@1.23.1", "medium@v1.0.0", "fast@v1.0.0"}, names)
+	})
+
+	t.Run("concurrent lookups for the same release are coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, mockTransport := newController(t, map[string]time.Duration{
+			"shared": 50 * time.Millisecond,
+		})
+
+		nodes := []*parsingv2.Node{
+			{OS: "linux", Arch: "amd64"},
+			{OS: "darwin", Arch: "arm64"},
+			{OS: "linux", Arch: "amd64"},
+		}
+
+		got := make([]string, len(nodes))
+		errs := make([]error, len(nodes))
+		done := make(chan struct{})
+		for i, node := range nodes {
+			go func() {
+				defer func() { done <- struct{}{} }()
+				seed, err := ctrl.renderSeed_githubRelease(
+					context.Background(),
+					&parsingv2.GithubRelease{Repo: "shared", Tag: "v1.0.0"},
+					node,
+				)
+				errs[i] = err
+				if err == nil {
+					got[i] = seed.GetGithubRelease().DownloadUrl
+				}
+			}()
+		}
+		for range nodes {
+			<-done
+		}
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		require.Equal(t, []string{"shared-linux-amd64-url", "shared-darwin-arm64-url", "shared-linux-amd64-url"}, got)
+		require.Equal(t, 1, mockTransport.GetCallCountInfo()["GET "+releaseURL("shared")])
+	})
+}
+
+func TestGithubRelease_LookupTimeout(t *testing.T) {
+	t.Parallel()
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://api.github.com/repos/some/hung/releases/tags/v1.0.0",
+		func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		},
+	)
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			HttpClient:           &http.Client{Transport: mockTransport},
+			ReleaseLookupTimeout: 20 * time.Millisecond,
+		},
+		nil,
+	)
+
+	_, err := ctrl.getReleaseAssets(ctrl.releaseHosts[""], "some/hung", "v1.0.0")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/carlmjohnson/requests"
+)
+
+type gitlabRelease struct {
+	TagName         string `json:"tag_name"`
+	UpcomingRelease bool   `json:"upcoming_release"`
+	Assets          struct {
+		Links []gitlabAssetLink `json:"links"`
+	} `json:"assets"`
+}
+
+type gitlabAssetLink struct {
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	DirectAssetURL string `json:"direct_asset_url"`
+}
+
+// gitlabProjectURL is the API url of a project. GitLab takes the full project path as a single, escaped, path segment,
+// so this can't go through Pathf
+func gitlabProjectURL(host ReleaseHost, repo string) string {
+	return host.ApiURL + "projects/" + url.PathEscape(repo)
+}
+
+// getGitlabReleaseAssets returns the release's asset links. GitLab's generated source archives aren't included, they're
+// never the binary being installed
+func (c *Controller) getGitlabReleaseAssets(host ReleaseHost, repo string, tag string) ([]githubAsset, error) {
+	var resp gitlabRelease
+	builder, err := host.authorize(
+		requests.
+			URL(gitlabProjectURL(host, repo) + "/releases/" + url.PathEscape(tag)).
+			Client(c.githubClient).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.releaseLookupContext(host)
+	defer cancel()
+	if err := builder.Fetch(ctx); err != nil {
+		return nil, fmt.Errorf("error getting release assets: %w", err)
+	}
+
+	assets := make([]githubAsset, 0, len(resp.Assets.Links))
+	for _, link := range resp.Assets.Links {
+		downloadURL := link.DirectAssetURL
+		if downloadURL == "" {
+			downloadURL = link.URL
+		}
+		assets = append(assets, githubAsset{Name: link.Name, DownloadUrl: downloadURL})
+	}
+
+	return assets, nil
+}
+
+// listGitlabReleases fetches releases newest first, following GitLab's X-Next-Page header up to releaseListMaxPages.
+// Upcoming releases are treated as drafts
+func (c *Controller) listGitlabReleases(host ReleaseHost, repo string) ([]githubReleaseListing, error) {
+	ctx, cancel := c.releaseLookupContext(host)
+	defer cancel()
+
+	out := []githubReleaseListing{}
+	page := "1"
+	for i := 0; i < releaseListMaxPages && page != ""; i++ {
+		var resp []gitlabRelease
+		headers := http.Header{}
+		builder, err := host.authorize(
+			requests.
+				URL(gitlabProjectURL(host, repo)+"/releases").
+				Param("per_page", strconv.Itoa(releaseListPageSize)).
+				Param("page", page).
+				Client(c.githubClient).
+				CopyHeaders(headers).
+				ToJSON(&resp),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := builder.Fetch(ctx); err != nil {
+			return nil, fmt.Errorf("error listing releases: %w", err)
+		}
+
+		for _, release := range resp {
+			out = append(out, githubReleaseListing{
+				TagName: release.TagName,
+				Draft:   release.UpcomingRelease,
+			})
+		}
+		page = headers.Get("X-Next-Page")
+	}
+
+	return out, nil
+}
@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitlabRelease(t *testing.T) {
+	t.Parallel()
+
+	node := &parsingv2.Node{OS: "linux", Arch: "amd64"}
+
+	gitlabRelease := func(links ...map[string]any) map[string]any {
+		return map[string]any{
+			"tag_name": "v1.0.0",
+			"assets": map[string]any{
+				"links": links,
+				"sources": []map[string]any{
+					{"format": "tar.gz", "url": "https://gitlab.com/some/group/tool/-/archive/v1.0.0/tool-v1.0.0.tar.gz"},
+				},
+			},
+		}
+	}
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://gitlab.com/api/v4/projects/some%2Fgroup%2Ftool/releases/v1.0.0",
+		func(req *http.Request) (*http.Response, error) {
+			require.Empty(t, req.Header.Get("PRIVATE-TOKEN"))
+			return httpmock.NewJsonResponse(http.StatusOK, gitlabRelease(
+				map[string]any{"name": "tool-darwin-arm64", "url": "https://gitlab.com/some/group/tool/-/releases/v1.0.0/downloads/tool-darwin-arm64"},
+				map[string]any{
+					"name":             "tool-linux-amd64",
+					"url":              "https://gitlab.com/some/group/tool/-/package_files/1/download",
+					"direct_asset_url": "https://gitlab.com/some/group/tool/-/releases/v1.0.0/downloads/tool-linux-amd64",
+				},
+			))
+		},
+	)
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://gitlab.example.com/api/v4/projects/internal%2Fcli/releases/v1.0.0",
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "some-gitlab-token", req.Header.Get("PRIVATE-TOKEN"))
+			return httpmock.NewJsonResponse(http.StatusOK, gitlabRelease(
+				map[string]any{"name": "cli_linux_amd64", "url": "https://gitlab.example.com/internal/cli/-/releases/v1.0.0/downloads/cli_linux_amd64"},
+			))
+		},
+	)
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://gitlab.example.com/api/v4/projects/internal%2Fmirrored/releases/v1.0.0",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, gitlabRelease(
+			map[string]any{"name": "mirrored_linux_amd64", "url": "https://downloads.example.net/mirrored_linux_amd64"},
+		)),
+	)
+
+	storage := NewMockStorageClient(t)
+	storage.EXPECT().ReadGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	storage.EXPECT().WriteGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			StorageClient:      storage,
+			HttpClient:         &http.Client{Transport: mockTransport},
+			GithubReleaseToken: "some-github-token",
+			ReleaseHosts: map[string]ReleaseHost{
+				"internal": {ApiURL: "https://gitlab.example.com/api/v4", Token: "some-gitlab-token"},
+			},
+		},
+		nil,
+	)
+
+	t.Run("public instance", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{Repo: "some/group/tool", Tag: "v1.0.0", Provider: "gitlab"},
+			node,
+		)
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.Seed{
+				Element: &pbv1.Seed_GithubRelease{
+					GithubRelease: &pbv1.GithubRelease{
+						DownloadUrl: "https://gitlab.com/some/group/tool/-/releases/v1.0.0/downloads/tool-linux-amd64",
+					},
+				},
+			},
+			got,
+		)
+	})
+
+	t.Run("named host", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{Repo: "internal/cli", Tag: "v1.0.0", Provider: "gitlab", Host: "internal"},
+			node,
+		)
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.Seed{
+				Element: &pbv1.Seed_GithubRelease{
+					GithubRelease: &pbv1.GithubRelease{
+						DownloadUrl: "https://gitlab.example.com/internal/cli/-/releases/v1.0.0/downloads/cli_linux_amd64",
+						Authentication: &pbv1.GithubRelease_Authentication{
+							BearerAuth: "Bearer some-gitlab-token",
+						},
+					},
+				},
+			},
+			got,
+		)
+	})
+
+	t.Run("external link", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{Repo: "internal/mirrored", Tag: "v1.0.0", Provider: "gitlab", Host: "internal"},
+			node,
+		)
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.Seed{
+				Element: &pbv1.Seed_GithubRelease{
+					GithubRelease: &pbv1.GithubRelease{
+						DownloadUrl: "https://downloads.example.net/mirrored_linux_amd64",
+					},
+				},
+			},
+			got,
+		)
+	})
+}
+
+func TestListGitlabReleases(t *testing.T) {
+	t.Parallel()
+
+	const releasesURL = "https://gitlab.com/api/v4/projects/some%2Ftool/releases"
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponderWithQuery(
+		http.MethodGet,
+		releasesURL,
+		map[string]string{"per_page": "100", "page": "1"},
+		func(req *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(http.StatusOK, []map[string]any{
+				{"tag_name": "v2.0.0", "upcoming_release": true},
+				{"tag_name": "v1.1.0"},
+			})
+			resp.Header.Set("X-Next-Page", "2")
+			return resp, err
+		},
+	)
+	mockTransport.RegisterResponderWithQuery(
+		http.MethodGet,
+		releasesURL,
+		map[string]string{"per_page": "100", "page": "2"},
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{
+			{"tag_name": "v1.0.0"},
+		}),
+	)
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			HttpClient: &http.Client{Transport: mockTransport},
+		},
+		nil,
+	)
+
+	host, err := ctrl.releaseHost(&parsingv2.GithubRelease{Provider: "gitlab"})
+	require.NoError(t, err)
+	releases, err := ctrl.listReleases(context.Background(), host, "some/tool")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]githubReleaseListing{
+			{TagName: "v2.0.0", Draft: true},
+			{TagName: "v1.1.0"},
+			{TagName: "v1.0.0"},
+		},
+		releases,
+	)
+
+	tag, err := selectReleaseTag(releases, parsingv2.TagLatest, false)
+	require.NoError(t, err)
+	require.Equal(t, "v1.1.0", tag)
+}
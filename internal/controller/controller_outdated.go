@@ -0,0 +1,252 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/Masterminds/semver/v3"
+	"github.com/carlmjohnson/requests"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"golang.org/x/mod/module"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	goProxyURL    = "https://proxy.golang.org"
+	goDownloadURL = "https://go.dev/dl/"
+)
+
+const (
+	// bounds how many upstreams are asked for their latest version at once
+	outdatedLookupConcurrency = 8
+)
+
+const (
+	outdatedKindGithubRelease = "github_release"
+	outdatedKindGoInstall     = "go_install"
+	outdatedKindGolang        = "golang"
+	outdatedKindGitRepo       = "git_repo"
+)
+
+type pinnedSeed struct {
+	kind    string
+	name    string
+	current string
+	roles   []string
+	// latest looks up the latest upstream version
+	latest func(ctx context.Context) (string, error)
+}
+
+func (c *Controller) ListOutdated(ctx context.Context, req *connect.Request[pbv1.ListOutdatedRequest]) (*connect.Response[pbv1.ListOutdatedResponse], error) {
+	if err := c.ensureConfig(); err != nil {
+		return nil, c.logAndHandleError(err, "error ensuring config")
+	}
+	conf, err := c.cloneConfig()
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error cloning config")
+	}
+
+	pinnedSeeds := c.pinnedSeeds(conf)
+	out := make([]*pbv1.OutdatedSeed, len(pinnedSeeds))
+	group := &errgroup.Group{}
+	group.SetLimit(outdatedLookupConcurrency)
+
+	for i, pinned := range pinnedSeeds {
+		group.Go(func() error {
+			outdated := &pbv1.OutdatedSeed{
+				Kind:    pinned.kind,
+				Name:    pinned.name,
+				Current: pinned.current,
+				Roles:   pinned.roles,
+			}
+
+			c.log.Debug().Msgf("checking latest version of %v %v", pinned.kind, pinned.name)
+			latest, err := pinned.latest(ctx)
+			if err != nil {
+				// One unreachable upstream shouldn't hide the rest of the report
+				c.log.Warn().Err(err).Msgf("error getting latest version of %v", pinned.name)
+				outdated.Error = err.Error()
+			} else {
+				outdated.Latest = latest
+				outdated.Outdated = isNewerVersion(pinned.current, latest)
+			}
+
+			out[i] = outdated
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, c.logAndHandleError(err, "error checking latest versions")
+	}
+
+	return connect.NewResponse(&pbv1.ListOutdatedResponse{
+		Seeds: out,
+	}), nil
+}
+
+// pinnedSeeds collects every seed pinned to a specific version, merging seeds used by several roles. Floating versions
+// are skipped, they're never out of date
+func (c *Controller) pinnedSeeds(conf *parsingv2.Config) []*pinnedSeed {
+	pinned := map[string]*pinnedSeed{}
+	add := func(role string, seed *pinnedSeed) {
+		key := strings.Join([]string{seed.kind, seed.name, seed.current}, "|")
+		existing, ok := pinned[key]
+		if !ok {
+			pinned[key] = seed
+			existing = seed
+		}
+		if !slices.Contains(existing.roles, role) {
+			existing.roles = append(existing.roles, role)
+		}
+	}
+
+	for role, seeds := range conf.Roles {
+		for _, seed := range seeds {
+			switch concrete := seed.Element.(type) {
+			case *parsingv2.GithubRelease:
+				if concrete.Floating() {
+					continue
+				}
+				add(role, &pinnedSeed{
+					kind:    outdatedKindGithubRelease,
+					name:    concrete.QualifiedRepo(),
+					current: concrete.Tag,
+					latest: func(ctx context.Context) (string, error) {
+						host, err := c.releaseHost(concrete)
+						if err != nil {
+							return "", err
+						}
+						releases, err := c.listReleases(ctx, host, concrete.Repo)
+						if err != nil {
+							return "", err
+						}
+						return selectReleaseTag(releases, parsingv2.TagLatest, concrete.IncludePrereleases)
+					},
+				})
+			case *parsingv2.GoInstall:
+				if concrete.Version == nil || *concrete.Version == parsingv2.TagLatest {
+					continue
+				}
+				add(role, &pinnedSeed{
+					kind:    outdatedKindGoInstall,
+					name:    concrete.Package,
+					current: *concrete.Version,
+					latest: func(ctx context.Context) (string, error) {
+						return c.getLatestGoModuleVersion(ctx, concrete.Package)
+					},
+				})
+			case *parsingv2.Golang:
+				add(role, &pinnedSeed{
+					kind:    outdatedKindGolang,
+					name:    "go",
+					current: concrete.Version,
+					latest:  c.getLatestGoVersion,
+				})
+			case *parsingv2.GitRepo:
+				if concrete.Tag == nil {
+					continue
+				}
+				add(role, &pinnedSeed{
+					kind:    outdatedKindGitRepo,
+					name:    concrete.URL,
+					current: *concrete.Tag,
+					latest: func(context.Context) (string, error) {
+						url := concrete.URL
+						if !strings.HasSuffix(url, ".git") {
+							url = url + ".git"
+						}
+						return c.git.GetLatestRelease(url)
+					},
+				})
+			}
+		}
+	}
+
+	out := make([]*pinnedSeed, 0, len(pinned))
+	for _, seed := range pinned {
+		slices.Sort(seed.roles)
+		out = append(out, seed)
+	}
+	slices.SortFunc(out, func(a *pinnedSeed, b *pinnedSeed) int {
+		return strings.Compare(
+			strings.Join([]string{a.kind, a.name, a.current}, "|"),
+			strings.Join([]string{b.kind, b.name, b.current}, "|"),
+		)
+	})
+
+	return out
+}
+
+// getLatestGoModuleVersion asks the module proxy for the latest version of the module providing pkg. Packages are
+// often nested inside their module, so parent paths are tried until one is a module
+func (c *Controller) getLatestGoModuleVersion(ctx context.Context, pkg string) (string, error) {
+	for modPath := pkg; modPath != "." && modPath != "/"; modPath = path.Dir(modPath) {
+		escaped, err := module.EscapePath(modPath)
+		if err != nil {
+			return "", fmt.Errorf("error escaping module path: %w", err)
+		}
+
+		var resp struct {
+			Version string `json:"Version"`
+		}
+		// Not Pathf, the escaped path is already in the form the proxy expects
+		err = requests.
+			URL(goProxyURL + "/" + escaped + "/@latest").
+			Client(c.httpClient).
+			ToJSON(&resp).
+			Fetch(ctx)
+		if err == nil {
+			return resp.Version, nil
+		}
+		// The proxy answers 404/410 for paths that aren't modules, anything else is a real failure
+		if !requests.HasStatusErr(err, http.StatusNotFound, http.StatusGone) {
+			return "", fmt.Errorf("error querying module proxy: %w", err)
+		}
+	}
+
+	return "", fmt.Errorf("no module found for %v", pkg)
+}
+
+// getLatestGoVersion returns the latest stable go release, without the "go" prefix to match the golang seed
+func (c *Controller) getLatestGoVersion(ctx context.Context) (string, error) {
+	var resp []struct {
+		Version string `json:"version"`
+		Stable  bool   `json:"stable"`
+	}
+	err := requests.
+		URL(goDownloadURL).
+		Param("mode", "json").
+		Client(c.httpClient).
+		ToJSON(&resp).
+		Fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error listing go releases: %w", err)
+	}
+
+	for _, release := range resp {
+		if release.Stable {
+			return strings.TrimPrefix(release.Version, "go"), nil
+		}
+	}
+
+	return "", errors.New("no stable go release found")
+}
+
+// isNewerVersion compares as semver where possible, falling back to treating any difference as newer
+func isNewerVersion(current string, latest string) bool {
+	currentVersion, currentErr := semver.NewVersion(current)
+	latestVersion, latestErr := semver.NewVersion(latest)
+	if currentErr != nil || latestErr != nil {
+		return current != latest
+	}
+	return latestVersion.GreaterThan(currentVersion)
+}
@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jarcoal/httpmock"
+	"github.com/nicjohnson145/hlp"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOutdated(t *testing.T) {
+	t.Parallel()
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://api.github.com/repos/some/repo/releases",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{
+			{"tag_name": "v2.0.0-rc1", "prerelease": true},
+			{"tag_name": "v1.5.0"},
+			{"tag_name": "v1.4.0"},
+		}),
+	)
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://api.github.com/repos/other/repo/releases",
+		httpmock.NewStringResponder(http.StatusInternalServerError, ""),
+	)
+	// Not a module itself, the module is the parent path
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://proxy.golang.org/github.com/!some/tool/cmd/tool/@latest",
+		httpmock.NewStringResponder(http.StatusNotFound, ""),
+	)
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://proxy.golang.org/github.com/!some/tool/cmd/@latest",
+		httpmock.NewStringResponder(http.StatusGone, ""),
+	)
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://proxy.golang.org/github.com/!some/tool/@latest",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{"Version": "v0.3.0"}),
+	)
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://go.dev/dl/?mode=json",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []map[string]any{
+			{"version": "go1.24rc1", "stable": false},
+			{"version": "go1.23.2", "stable": true},
+		}),
+	)
+
+	gitClient := NewMockGitClient(t)
+	gitClient.EXPECT().GetLatestRelease("https://github.com/some/dotfiles.git").Return("v1.0.0", nil)
+
+	release := &parsingv2.Seed{Element: &parsingv2.GithubRelease{Repo: "some/repo", Tag: "v1.4.0"}}
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			GitClient:  gitClient,
+			HttpClient: &http.Client{Transport: mockTransport},
+		},
+		&parsingv2.Config{
+			Roles: map[string][]*parsingv2.Seed{
+				"base": {
+					release,
+					{Element: &parsingv2.Golang{Version: "1.23.2"}},
+					{Element: &parsingv2.GithubRelease{Repo: "floating/repo", Tag: "latest"}},
+					{Element: &parsingv2.GoInstall{Package: "github.com/Some/tool/cmd/tool", Version: hlp.Ptr("v0.2.1")}},
+					{Element: &parsingv2.GoInstall{Package: "github.com/unpinned/tool"}},
+				},
+				"dev": {
+					release,
+					{Element: &parsingv2.GithubRelease{Repo: "other/repo", Tag: "v1.0.0"}},
+					{Element: &parsingv2.GitRepo{URL: "https://github.com/some/dotfiles", Location: "~/.dotfiles", Tag: hlp.Ptr("v1.0.0")}},
+					{Element: &parsingv2.GitRepo{URL: "https://github.com/some/other", Location: "~/.other", Commit: hlp.Ptr("abc123")}},
+				},
+			},
+		},
+	)
+
+	resp, err := ctrl.ListOutdated(context.Background(), connect.NewRequest(&pbv1.ListOutdatedRequest{}))
+	require.NoError(t, err)
+
+	// Errors are reported by message, which is noisy to match on exactly
+	require.Len(t, resp.Msg.Seeds, 5)
+	require.NotEmpty(t, resp.Msg.Seeds[1].Error)
+	resp.Msg.Seeds[1].Error = ""
+
+	pbEqual(
+		t,
+		[]*pbv1.OutdatedSeed{
+			{
+				Kind:    "git_repo",
+				Name:    "https://github.com/some/dotfiles",
+				Current: "v1.0.0",
+				Latest:  "v1.0.0",
+				Roles:   []string{"dev"},
+			},
+			{
+				Kind:     "github_release",
+				Name:     "other/repo",
+				Current:  "v1.0.0",
+				Roles:    []string{"dev"},
+				Outdated: false,
+			},
+			{
+				Kind:     "github_release",
+				Name:     "some/repo",
+				Current:  "v1.4.0",
+				Latest:   "v1.5.0",
+				Roles:    []string{"base", "dev"},
+				Outdated: true,
+			},
+			{
+				Kind:     "go_install",
+				Name:     "github.com/Some/tool/cmd/tool",
+				Current:  "v0.2.1",
+				Latest:   "v0.3.0",
+				Roles:    []string{"base"},
+				Outdated: true,
+			},
+			{
+				Kind:    "golang",
+				Name:    "go",
+				Current: "1.23.2",
+				Latest:  "1.23.2",
+				Roles:   []string{"base"},
+			},
+		},
+		resp.Msg.Seeds,
+	)
+}
+
+func TestListOutdated_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	mockTransport := httpmock.NewMockTransport()
+	// Upstreams that never answer
+	hang := func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+	mockTransport.RegisterResponder(http.MethodGet, "https://go.dev/dl/?mode=json", hang)
+	mockTransport.RegisterResponder(http.MethodGet, `=~^https://api\.github\.com/repos/some/tool/releases`, hang)
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			HttpClient: &http.Client{Transport: mockTransport},
+		},
+		&parsingv2.Config{
+			Roles: map[string][]*parsingv2.Seed{
+				"base": {
+					{Element: &parsingv2.Golang{Version: "1.23.2"}},
+					{Element: &parsingv2.GithubRelease{Repo: "some/tool", Tag: "v1.0.0"}},
+				},
+			},
+		},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Well before the release lookup timeout, the shared release listing doesn't hold the request up
+	start := time.Now()
+	_, err := ctrl.ListOutdated(ctx, connect.NewRequest(&pbv1.ListOutdatedRequest{}))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestIsNewerVersion(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isNewerVersion("v1.4.0", "v1.5.0"))
+	require.False(t, isNewerVersion("v1.5.0", "v1.4.0"))
+	require.False(t, isNewerVersion("v1.5.0", "v1.5.0"))
+	require.True(t, isNewerVersion("1.22", "1.23.2"))
+	require.True(t, isNewerVersion("nightly-1", "nightly-2"))
+	require.False(t, isNewerVersion("nightly-1", "nightly-1"))
+}
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// when the default channel hasn't moved, channels tracking a branch are only checked every this many polls, since
+	// each costs a remote call
+	channelPollEvery = 5
+)
+
+type pollResult struct {
+	polledAt time.Time
+	commit   string
+	changed  bool
+	err      error
+}
+
+// Poll checks the config repo for a new commit straight away and then every PollInterval until ctx is done, for setups
+// that can't receive webhooks. The outcome is logged and reported by ListConfigRevisions. It returns immediately if
+// polling is disabled
+func (c *Controller) Poll(ctx context.Context) {
+	if c.pollInterval <= 0 {
+		return
+	}
+
+	c.log.Info().Msgf("polling %v every %v", c.repoUrl, c.pollInterval)
+	c.pollOnce(ctx)
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.log.Debug().Msg("stopping config poll")
+			return
+		case <-ticker.C:
+			c.pollOnce(ctx)
+		}
+	}
+}
+
+func (c *Controller) pollOnce(ctx context.Context) {
+	commit, changed, err := c.pollConfig(ctx)
+
+	c.pollMu.Lock()
+	c.lastPoll = &pollResult{
+		polledAt: c.now(),
+		commit:   commit,
+		changed:  changed,
+		err:      err,
+	}
+	c.pollMu.Unlock()
+
+	switch {
+	case err != nil:
+		c.log.Err(err).Msg("error polling config")
+	case changed:
+		c.log.Info().Msgf("poll loaded %v", commit)
+	default:
+		c.log.Debug().Msgf("poll found no change, still at %v", commit)
+	}
+}
+
+// pollConfig reloads the default channel if its latest commit moved, returning the commit being served and if it
+// changed. Channels follow the default channel when it moves, otherwise they're checked on a slower schedule
+func (c *Controller) pollConfig(ctx context.Context) (string, bool, error) {
+	if c.currentCommit() == "" {
+		// Nothing served yet, so nothing to compare against or sync agents for
+		if err := c.ensureConfig(); err != nil {
+			return "", false, fmt.Errorf("error ensuring config: %w", err)
+		}
+		return c.currentCommit(), true, nil
+	}
+
+	previous, changed, err := c.pollDefaultChannel(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	if !changed {
+		c.pollChannels(ctx)
+		return c.currentCommit(), false, nil
+	}
+
+	c.channelPolls = 0
+	if _, err := c.syncAfterUpdate(ctx, previous); err != nil {
+		return c.currentCommit(), true, fmt.Errorf("error syncing after update: %w", err)
+	}
+
+	return c.currentCommit(), true, nil
+}
+
+// pollDefaultChannel loads the latest commit under updateMu if it moved and the default channel isn't pinned,
+// returning the config it replaced and if it changed
+func (c *Controller) pollDefaultChannel(ctx context.Context) (*parsingv2.Config, bool, error) {
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+
+	pinned, err := c.pinnedCommit(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if pinned != "" {
+		c.log.Debug().Msgf("config pinned to %v, only checking channels", pinned)
+		return nil, false, nil
+	}
+
+	latest, err := c.latestCommit()
+	if err != nil {
+		return nil, false, fmt.Errorf("error getting latest commit: %w", err)
+	}
+	if latest == c.currentCommit() {
+		return nil, false, nil
+	}
+
+	// Load the commit that was compared, rather than resolving it again and maybe getting a newer one
+	config, err := c.parseConfigAt(latest)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return c.setUnpinnedConfig(ctx, config, latest, ConfigTriggerPoll)
+}
+
+// pollChannels refreshes the channels every channelPollEvery polls, if any track a branch. Other channels only move
+// with the default channel or a promotion, both of which refresh them already
+func (c *Controller) pollChannels(ctx context.Context) {
+	if !c.channelTracksBranch() {
+		return
+	}
+
+	c.channelPolls++
+	if c.channelPolls < channelPollEvery {
+		return
+	}
+	c.channelPolls = 0
+	c.refreshChannels(ctx)
+}
+
+func (c *Controller) lastPollPB() *pbv1.ConfigPoll {
+	c.pollMu.Lock()
+	defer c.pollMu.Unlock()
+
+	if c.lastPoll == nil {
+		return nil
+	}
+
+	out := &pbv1.ConfigPoll{
+		PolledAt: timestamppb.New(c.lastPoll.polledAt),
+		Commit:   c.lastPoll.commit,
+		Changed:  c.lastPoll.changed,
+	}
+	if c.lastPoll.err != nil {
+		out.Error = c.lastPoll.err.Error()
+	}
+	return out
+}
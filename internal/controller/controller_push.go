@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	agentv1 "github.com/nicjohnson145/plantr/gen/plantr/agent/v1"
+	"github.com/nicjohnson145/plantr/gen/plantr/agent/v1/agentv1connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/interceptors"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+)
+
+const (
+	defaultPushSyncTimeout = 10 * time.Minute
+)
+
+// queuedSync is the work left over from a webhook, run in the background since github only waits 10s for a response
+type queuedSync struct {
+	// defaultChanged is set if the default channel moved, from the previous config. Otherwise only the channels need
+	// refreshing
+	defaultChanged bool
+	previous       *parsingv2.Config
+}
+
+// queueSync runs the queued sync in the background. Only one runs at a time, and a burst of pushes collapses into a single
+// follow up run covering every change since the oldest of them
+func (c *Controller) queueSync(queued queuedSync) {
+	c.syncMu.Lock()
+	defer c.syncMu.Unlock()
+
+	if !c.syncRunning {
+		c.syncRunning = true
+		go c.runQueuedSyncs(queued)
+		return
+	}
+
+	// An already queued default change is older, so its previous config covers this one too
+	if c.syncPending == nil || (queued.defaultChanged && !c.syncPending.defaultChanged) {
+		c.syncPending = &queued
+	}
+}
+
+func (c *Controller) runQueuedSyncs(queued queuedSync) {
+	for {
+		c.runQueuedSync(queued)
+
+		c.syncMu.Lock()
+		if c.syncPending == nil {
+			c.syncRunning = false
+			c.syncMu.Unlock()
+			return
+		}
+		queued = *c.syncPending
+		c.syncPending = nil
+		c.syncMu.Unlock()
+	}
+}
+
+func (c *Controller) runQueuedSync(queued queuedSync) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.pushSyncTimeout)
+	defer cancel()
+
+	if queued.defaultChanged && c.pushSyncEnabled {
+		current, err := c.cloneConfig()
+		if err != nil {
+			c.log.Err(err).Msg("error cloning config for push sync")
+		} else {
+			c.pushSync(ctx, "", queued.previous, current)
+		}
+	}
+	// Channels following the default channel move along with it
+	c.refreshChannels(ctx)
+}
+
+// pushSync asks the agent of every node on channel affected by the change from previous to current to sync, at most
+// pushSyncConcurrency at a time. Results are returned in the same order as the nodes appear in the config
+func (c *Controller) pushSync(ctx context.Context, channel string, previous *parsingv2.Config, current *parsingv2.Config) []*pbv1.PushSyncResult {
+	nodes := c.affectedNodes(channel, previous, current)
+	if len(nodes) == 0 {
+		c.log.Info().Msg("no nodes affected by config change, skipping push sync")
+		return nil
+	}
+
+	c.log.Info().Msgf("pushing sync to %v affected node(s)", len(nodes))
+
+	results := make([]*pbv1.PushSyncResult, len(nodes))
+	sem := make(chan struct{}, c.pushSyncConcurrency)
+	wg := sync.WaitGroup{}
+
+	for i, node := range nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := &pbv1.PushSyncResult{
+				NodeId: node.ID,
+				Result: pbv1.SyncResult_SYNC_RESULT_SUCCESS,
+			}
+			if err := c.syncAgent(ctx, node); err != nil {
+				c.log.Err(err).Msgf("error pushing sync to %v", node.ID)
+				result.Result = pbv1.SyncResult_SYNC_RESULT_FAILURE
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}()
+	}
+
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Result != pbv1.SyncResult_SYNC_RESULT_SUCCESS {
+			failed++
+		}
+	}
+	c.log.Info().Msgf("push sync complete, %v succeeded, %v failed", len(results)-failed, failed)
+
+	return results
+}
+
+func (c *Controller) syncAgent(ctx context.Context, node *parsingv2.Node) error {
+	tokenStr, err := c.newAgentToken(node.ID)
+	if err != nil {
+		return fmt.Errorf("error generating agent token: %w", err)
+	}
+
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	client := agentv1connect.NewAgentServiceClient(
+		httpClient,
+		node.AgentAddress,
+		connect.WithInterceptors(interceptors.NewClientAuthInterceptor(tokenStr)),
+	)
+
+	if _, err := client.Sync(ctx, connect.NewRequest(&agentv1.SyncRequest{})); err != nil {
+		return fmt.Errorf("error syncing agent: %w", err)
+	}
+
+	return nil
+}
+
+// affectedNodes returns the nodes in current served from channel that have an agent address and whose seed hashes
+// differ from previous
+func (c *Controller) affectedNodes(channel string, previous *parsingv2.Config, current *parsingv2.Config) []*parsingv2.Node {
+	affected := []*parsingv2.Node{}
+	for _, node := range current.Nodes {
+		if !c.inChannel(channel, node) {
+			continue
+		}
+		if node.AgentAddress == "" {
+			c.log.Debug().Msgf("node %v has no agent address, skipping", node.ID)
+			continue
+		}
+
+		currentHashes, err := c.seedHashes(current, node)
+		if err != nil {
+			// Let the agent sort it out, it'll get the same error from GetSyncData and report it back
+			c.log.Warn().Err(err).Msgf("error computing seed hashes for %v, treating as affected", node.ID)
+			affected = append(affected, node)
+			continue
+		}
+
+		var previousNode *parsingv2.Node
+		if previous != nil {
+			previousNode = c.findNode(previous, node.ID)
+		}
+		if previousNode == nil {
+			affected = append(affected, node)
+			continue
+		}
+
+		previousHashes, err := c.seedHashes(previous, previousNode)
+		if err != nil || !slices.Equal(previousHashes, currentHashes) {
+			affected = append(affected, node)
+		}
+	}
+
+	return affected
+}
+
+func (c *Controller) seedHashes(conf *parsingv2.Config, node *parsingv2.Node) ([]string, error) {
+	seeds, err := c.nodeSeeds(conf, node)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes, err := c.hashSeeds(node, seeds)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(hashes)
+	return slices.Compact(hashes), nil
+}
@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	agentv1 "github.com/nicjohnson145/plantr/gen/plantr/agent/v1"
+	"github.com/nicjohnson145/plantr/gen/plantr/agent/v1/agentv1connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/interceptors"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAgent struct {
+	agentv1connect.UnimplementedAgentServiceHandler
+
+	err      error
+	delay    time.Duration
+	calls    *atomic.Int32
+	inFlight *atomic.Int32
+	maxSeen  *atomic.Int32
+}
+
+func (f *fakeAgent) Sync(context.Context, *connect.Request[agentv1.SyncRequest]) (*connect.Response[agentv1.SyncResponse], error) {
+	f.calls.Add(1)
+	current := f.inFlight.Add(1)
+	defer f.inFlight.Add(-1)
+
+	for {
+		seen := f.maxSeen.Load()
+		if current <= seen || f.maxSeen.CompareAndSwap(seen, current) {
+			break
+		}
+	}
+
+	time.Sleep(f.delay)
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	return connect.NewResponse(&agentv1.SyncResponse{}), nil
+}
+
+func TestController_PushSync(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte(`some-agent-secret`)
+
+	type agentServer struct {
+		address string
+		calls   *atomic.Int32
+	}
+
+	inFlight := &atomic.Int32{}
+	maxSeen := &atomic.Int32{}
+	newAgent := func(t *testing.T, nodeID string, err error) agentServer {
+		t.Helper()
+
+		calls := &atomic.Int32{}
+		mux := http.NewServeMux()
+		mux.Handle(agentv1connect.NewAgentServiceHandler(
+			&fakeAgent{err: err, delay: 20 * time.Millisecond, calls: calls, inFlight: inFlight, maxSeen: maxSeen},
+			connect.WithInterceptors(interceptors.NewAuthInterceptor(zerolog.Nop(), interceptors.AuthInterceptorConfig{
+				SigningKey:    secret,
+				Audience:      nodeID,
+				Authorization: interceptors.AgentAuthorizationTable,
+			})),
+		))
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+
+		return agentServer{address: srv.URL, calls: calls}
+	}
+
+	unchanged := newAgent(t, "unchanged", nil)
+	changed := newAgent(t, "changed", nil)
+	added := newAgent(t, "added", nil)
+	broken := newAgent(t, "broken", connect.NewError(connect.CodeInternal, fmt.Errorf("kaboom")))
+
+	roles := func(version string) map[string][]*parsingv2.Seed {
+		return map[string][]*parsingv2.Seed{
+			"stable": {
+				{Element: &parsingv2.Golang{Version: "1.23.1"}},
+			},
+			"moving": {
+				{Element: &parsingv2.Golang{Version: version}},
+			},
+		}
+	}
+
+	previous := &parsingv2.Config{
+		Roles: roles("1.22.0"),
+		Nodes: []*parsingv2.Node{
+			{ID: "unchanged", Roles: []string{"stable"}, AgentAddress: unchanged.address},
+			{ID: "changed", Roles: []string{"moving"}, AgentAddress: changed.address},
+			{ID: "broken", Roles: []string{"moving"}, AgentAddress: broken.address},
+			{ID: "no-address", Roles: []string{"moving"}},
+		},
+	}
+	current := &parsingv2.Config{
+		Roles: roles("1.23.0"),
+		Nodes: []*parsingv2.Node{
+			{ID: "unchanged", Roles: []string{"stable"}, AgentAddress: unchanged.address},
+			{ID: "changed", Roles: []string{"moving"}, AgentAddress: changed.address},
+			{ID: "broken", Roles: []string{"moving"}, AgentAddress: broken.address},
+			{ID: "no-address", Roles: []string{"moving"}},
+			{ID: "added", Roles: []string{"stable"}, AgentAddress: added.address},
+		},
+	}
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			AgentAuthSecret:     secret,
+			PushSyncEnabled:     true,
+			PushSyncConcurrency: 2,
+		},
+		nil,
+	)
+
+	got := ctrl.pushSync(context.Background(), "", previous, current)
+	pbEqual(
+		t,
+		[]*pbv1.PushSyncResult{
+			{NodeId: "changed", Result: pbv1.SyncResult_SYNC_RESULT_SUCCESS},
+			{NodeId: "broken", Result: pbv1.SyncResult_SYNC_RESULT_FAILURE, Error: "error syncing agent: internal: kaboom"},
+			{NodeId: "added", Result: pbv1.SyncResult_SYNC_RESULT_SUCCESS},
+		},
+		got,
+	)
+
+	require.Equal(t, int32(0), unchanged.calls.Load())
+	require.Equal(t, int32(1), changed.calls.Load())
+	require.Equal(t, int32(1), broken.calls.Load())
+	require.Equal(t, int32(1), added.calls.Load())
+	require.LessOrEqual(t, maxSeen.Load(), int32(2))
+}
+
+func TestController_PushSync_NoAgentAuth(t *testing.T) {
+	t.Parallel()
+
+	ctrl := newControllerWithConfig(t, ControllerConfig{PushSyncEnabled: true}, nil)
+
+	got := ctrl.pushSync(context.Background(), "", nil, &parsingv2.Config{
+		Nodes: []*parsingv2.Node{
+			{ID: "some-node", AgentAddress: "http://some-node.example.com:8080"},
+		},
+	})
+	require.Len(t, got, 1)
+	require.Equal(t, pbv1.SyncResult_SYNC_RESULT_FAILURE, got[0].Result)
+	require.Contains(t, got[0].Error, ErrAgentAuthNotConfiguredError.Error())
+}
+
+func TestController_QueueSync(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte(`some-agent-secret`)
+
+	newQueueController := func(t *testing.T, delay time.Duration, timeout time.Duration) (*Controller, *fakeAgent) {
+		t.Helper()
+
+		agent := &fakeAgent{delay: delay, calls: &atomic.Int32{}, inFlight: &atomic.Int32{}, maxSeen: &atomic.Int32{}}
+		mux := http.NewServeMux()
+		mux.Handle(agentv1connect.NewAgentServiceHandler(agent))
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+
+		ctrl := newControllerWithConfig(
+			t,
+			ControllerConfig{
+				AgentAuthSecret: secret,
+				PushSyncEnabled: true,
+				PushSyncTimeout: timeout,
+			},
+			nil,
+		)
+		ctrl.config = &parsingv2.Config{
+			Nodes: []*parsingv2.Node{
+				{ID: "some-node", AgentAddress: srv.URL},
+			},
+		}
+
+		return ctrl, agent
+	}
+
+	idle := func(ctrl *Controller) func() bool {
+		return func() bool {
+			ctrl.syncMu.Lock()
+			defer ctrl.syncMu.Unlock()
+			return !ctrl.syncRunning
+		}
+	}
+
+	t.Run("coalesces bursts", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, agent := newQueueController(t, 100*time.Millisecond, time.Minute)
+
+		for range 5 {
+			ctrl.queueSync(queuedSync{defaultChanged: true})
+		}
+		require.Eventually(t, idle(ctrl), 5*time.Second, 10*time.Millisecond)
+
+		// One for the first push, one covering the rest
+		require.Equal(t, int32(2), agent.calls.Load())
+		require.Equal(t, int32(1), agent.maxSeen.Load())
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, agent := newQueueController(t, time.Second, 50*time.Millisecond)
+
+		ctrl.queueSync(queuedSync{defaultChanged: true})
+		require.Eventually(t, idle(ctrl), 500*time.Millisecond, 10*time.Millisecond)
+		require.Equal(t, int32(1), agent.calls.Load())
+	})
+}
@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrNoCommitError        = errors.New("no commit specified")
+	ErrAmbiguousCommitError = errors.New("ambiguous commit")
+	ErrInvalidCommitError   = errors.New("invalid commit")
+	ErrUnknownCommitError   = errors.New("unknown commit")
+)
+
+const (
+	defaultRevisionLimit = 20
+	// how far back short commits are looked up when pinning
+	revisionSearchLimit = 100
+	// revisions beyond this many are deleted as new ones are recorded
+	revisionRetention = 500
+	// full commits are 40 hex characters, git's own minimum abbreviation is 4
+	minShortCommitLength = 4
+	fullCommitLength     = 40
+)
+
+var commitExp = regexp.MustCompile(`^[0-9a-f]+$`)
+
+var configTriggerToPB = map[ConfigTrigger]pbv1.ConfigTrigger{
+	ConfigTriggerStartup:      pbv1.ConfigTrigger_CONFIG_TRIGGER_STARTUP,
+	ConfigTriggerWebhook:      pbv1.ConfigTrigger_CONFIG_TRIGGER_WEBHOOK,
+	ConfigTriggerForceRefresh: pbv1.ConfigTrigger_CONFIG_TRIGGER_FORCE_REFRESH,
+	ConfigTriggerPin:          pbv1.ConfigTrigger_CONFIG_TRIGGER_PIN,
+	ConfigTriggerUnpin:        pbv1.ConfigTrigger_CONFIG_TRIGGER_UNPIN,
+	ConfigTriggerPoll:         pbv1.ConfigTrigger_CONFIG_TRIGGER_POLL,
+}
+
+func (c *Controller) ListConfigRevisions(ctx context.Context, req *connect.Request[pbv1.ListConfigRevisionsRequest]) (*connect.Response[pbv1.ListConfigRevisionsResponse], error) {
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultRevisionLimit
+	}
+	limit = min(limit, revisionRetention)
+
+	revisions, err := c.store.ReadConfigRevisions(ctx, limit)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error reading revisions")
+	}
+
+	pinned, err := c.pinnedCommit(ctx)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error reading pin")
+	}
+
+	resp := &pbv1.ListConfigRevisionsResponse{
+		CurrentCommit: c.currentCommit(),
+		PinnedCommit:  pinned,
+		LastPoll:      c.lastPollPB(),
+	}
+	for _, revision := range revisions {
+		resp.Revisions = append(resp.Revisions, &pbv1.ConfigRevision{
+			Commit:   revision.Commit,
+			LoadedAt: timestamppb.New(revision.LoadedAt),
+			Trigger:  configTriggerToPB[revision.Trigger],
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// PinConfig serves the default channel from the given commit, ignoring webhooks and refreshes until unpinned
+func (c *Controller) PinConfig(ctx context.Context, req *connect.Request[pbv1.PinConfigRequest]) (*connect.Response[pbv1.PinConfigResponse], error) {
+	if req.Msg.Commit == "" {
+		return nil, c.logAndHandleError(ErrNoCommitError, "error validating")
+	}
+
+	commit, err := c.expandCommit(ctx, req.Msg.Commit)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error expanding commit")
+	}
+
+	// Load before pinning, so a commit that doesn't parse can't be pinned
+	config, err := c.parseConfigAt(commit)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error loading config")
+	}
+
+	previous, err := c.pinConfig(ctx, config, commit)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error writing pin")
+	}
+
+	results, err := c.syncAfterUpdate(ctx, previous)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error syncing agents")
+	}
+
+	return connect.NewResponse(&pbv1.PinConfigResponse{
+		Commit:      commit,
+		PushResults: results,
+	}), nil
+}
+
+// pinConfig records the pin and swaps in its config under updateMu, so a load that started before it can't land after
+func (c *Controller) pinConfig(ctx context.Context, config *parsingv2.Config, commit string) (*parsingv2.Config, error) {
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+
+	err := c.store.WriteChannelPin(ctx, &DBChannelPin{
+		Channel:  "",
+		Commit:   commit,
+		PinnedAt: c.now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Info().Msgf("pinned config to %v", commit)
+	return c.setConfig(ctx, config, commit, ConfigTriggerPin), nil
+}
+
+// UnpinConfig removes any pin and goes back to serving the latest commit
+func (c *Controller) UnpinConfig(ctx context.Context, req *connect.Request[pbv1.UnpinConfigRequest]) (*connect.Response[pbv1.UnpinConfigResponse], error) {
+	if err := c.store.DeleteChannelPin(ctx, ""); err != nil {
+		return nil, c.logAndHandleError(err, "error deleting pin")
+	}
+
+	c.log.Info().Msg("unpinned config")
+	previous, err := c._updateConfig(ctx, ConfigTriggerUnpin)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error updating config")
+	}
+
+	results, err := c.syncAfterUpdate(ctx, previous)
+	if err != nil {
+		return nil, c.logAndHandleError(err, "error syncing agents")
+	}
+
+	return connect.NewResponse(&pbv1.UnpinConfigResponse{
+		Commit:      c.currentCommit(),
+		PushResults: results,
+	}), nil
+}
+
+// pinnedCommit is the commit the default channel is pinned to, empty if it isn't
+func (c *Controller) pinnedCommit(ctx context.Context) (string, error) {
+	pin, err := c.store.ReadChannelPin(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("error reading pin: %w", err)
+	}
+	if pin == nil {
+		return "", nil
+	}
+	return pin.Commit, nil
+}
+
+// expandCommit resolves a short commit against recently loaded revisions. Full commits are taken as they are, so a
+// commit that was never loaded can still be pinned
+func (c *Controller) expandCommit(ctx context.Context, short string) (string, error) {
+	short = strings.ToLower(short)
+	if len(short) < minShortCommitLength || len(short) > fullCommitLength || !commitExp.MatchString(short) {
+		return "", fmt.Errorf("%w: %q isn't %v to %v hex characters", ErrInvalidCommitError, short, minShortCommitLength, fullCommitLength)
+	}
+	if len(short) == fullCommitLength {
+		return short, nil
+	}
+
+	revisions, err := c.store.ReadConfigRevisions(ctx, revisionSearchLimit)
+	if err != nil {
+		return "", fmt.Errorf("error reading revisions: %w", err)
+	}
+
+	matches := map[string]struct{}{}
+	for _, revision := range revisions {
+		if strings.HasPrefix(revision.Commit, short) {
+			matches[revision.Commit] = struct{}{}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w: %v doesn't match any of the last %v revisions, use the full commit", ErrUnknownCommitError, short, revisionSearchLimit)
+	case 1:
+		for commit := range matches {
+			return commit, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %v matches %v revisions", ErrAmbiguousCommitError, short, len(matches))
+}
+
+func (c *Controller) recordRevision(ctx context.Context, commit string, trigger ConfigTrigger) {
+	err := c.store.WriteConfigRevision(ctx, &DBConfigRevision{
+		Commit:   commit,
+		LoadedAt: c.now(),
+		Trigger:  trigger,
+	})
+	if err != nil {
+		// The config is already being served, failing to record it shouldn't stop that
+		c.log.Err(err).Msgf("error recording revision %v", commit)
+		return
+	}
+	if err := c.store.PruneConfigRevisions(ctx, revisionRetention); err != nil {
+		c.log.Err(err).Msg("error pruning revisions")
+	}
+}
+
+// syncAfterUpdate pushes a sync to the agents affected by the default channel changing from previous, then refreshes
+// the channels, some of which may follow it
+func (c *Controller) syncAfterUpdate(ctx context.Context, previous *parsingv2.Config) ([]*pbv1.PushSyncResult, error) {
+	results := []*pbv1.PushSyncResult{}
+	if c.pushSyncEnabled {
+		conf, err := c.cloneConfig()
+		if err != nil {
+			return nil, fmt.Errorf("error cloning config: %w", err)
+		}
+		results = c.pushSync(ctx, "", previous, conf)
+	}
+
+	return append(results, c.refreshChannels(ctx)...), nil
+}
@@ -0,0 +1,358 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestController_Revisions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		repoUrl   = "https://github.com/some/config.git"
+		oldCommit = "aaaa1111"
+		newCommit = "bbbb2222"
+	)
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	webhookSecret := []byte("some-webhook-secret")
+
+	type fakeStore struct {
+		latest      string
+		latestCalls int
+		// cloneHook, if set, runs before each clone
+		cloneHook func(commit string)
+		pins      map[string]*DBChannelPin
+		revisions []DBConfigRevision
+	}
+
+	newRevisionController := func(t *testing.T) (*Controller, *fakeStore) {
+		t.Helper()
+
+		store := &fakeStore{
+			latest: oldCommit,
+			pins:   map[string]*DBChannelPin{},
+		}
+		repos := map[string]fs.FS{
+			oldCommit: channelRepo("old-pkg"),
+			newCommit: channelRepo("new-pkg"),
+		}
+
+		gitClient := NewMockGitClient(t)
+		gitClient.EXPECT().GetLatestCommit(repoUrl).RunAndReturn(func(string) (string, error) {
+			store.latestCalls++
+			return store.latest, nil
+		}).Maybe()
+		gitClient.EXPECT().CloneAtCommit(repoUrl, mock.Anything).RunAndReturn(func(_ string, commit string) (fs.FS, error) {
+			if store.cloneHook != nil {
+				store.cloneHook(commit)
+			}
+			return repos[commit], nil
+		}).Maybe()
+
+		storage := NewMockStorageClient(t)
+		storage.EXPECT().ReadChannelPin(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, channel string) (*DBChannelPin, error) {
+			return store.pins[channel], nil
+		}).Maybe()
+		storage.EXPECT().WriteChannelPin(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, pin *DBChannelPin) error {
+			store.pins[pin.Channel] = pin
+			return nil
+		}).Maybe()
+		storage.EXPECT().DeleteChannelPin(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, channel string) error {
+			delete(store.pins, channel)
+			return nil
+		}).Maybe()
+		storage.EXPECT().WriteConfigRevision(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, revision *DBConfigRevision) error {
+			store.revisions = append(store.revisions, *revision)
+			return nil
+		}).Maybe()
+		storage.EXPECT().PruneConfigRevisions(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, keep int) error {
+			store.revisions = store.revisions[max(0, len(store.revisions)-keep):]
+			return nil
+		}).Maybe()
+		storage.EXPECT().ReadConfigRevisions(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, limit int) ([]DBConfigRevision, error) {
+			out := slices.Clone(store.revisions)
+			slices.Reverse(out)
+			return out[:min(limit, len(out))], nil
+		}).Maybe()
+
+		ctrl := newControllerWithConfig(
+			t,
+			ControllerConfig{
+				GitClient:           gitClient,
+				StorageClient:       storage,
+				RepoURL:             repoUrl,
+				GithubWebhookSecret: webhookSecret,
+				NowFunc: func() time.Time {
+					return now
+				},
+			},
+			nil,
+		)
+
+		return ctrl, store
+	}
+
+	servedCommit := func(t *testing.T, ctrl *Controller) string {
+		t.Helper()
+
+		_, _, commit, err := ctrl.collectSeeds(context.Background(), "default-node")
+		require.NoError(t, err)
+		return commit
+	}
+
+	pushRequest := func(t *testing.T, after string) *http.Request {
+		t.Helper()
+
+		body := []byte(`{"ref": "refs/heads/main", "after": "` + after + `", "repository": {"default_branch": "main"}}`)
+		mac := hmac.New(sha256.New, webhookSecret)
+		mac.Write(body)
+
+		req, err := http.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		return req
+	}
+
+	push := func(t *testing.T, ctrl *Controller, after string) {
+		t.Helper()
+
+		require.NoError(t, ctrl.handleGithubWebhook(pushRequest(t, after)))
+	}
+
+	t.Run("records revisions", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, store := newRevisionController(t)
+
+		require.Equal(t, oldCommit, servedCommit(t, ctrl))
+
+		// Nothing changed, so nothing to record
+		_, err := ctrl.ForceRefresh(context.Background(), connect.NewRequest(&pbv1.ForceRefreshRequest{}))
+		require.NoError(t, err)
+
+		store.latest = newCommit
+		push(t, ctrl, newCommit)
+		require.Equal(t, newCommit, servedCommit(t, ctrl))
+
+		require.Equal(
+			t,
+			[]DBConfigRevision{
+				{Commit: oldCommit, LoadedAt: now, Trigger: ConfigTriggerStartup},
+				{Commit: newCommit, LoadedAt: now, Trigger: ConfigTriggerWebhook},
+			},
+			store.revisions,
+		)
+	})
+
+	t.Run("pin and unpin", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, store := newRevisionController(t)
+		require.Equal(t, oldCommit, servedCommit(t, ctrl))
+
+		store.latest = newCommit
+		_, err := ctrl.ForceRefresh(context.Background(), connect.NewRequest(&pbv1.ForceRefreshRequest{}))
+		require.NoError(t, err)
+		require.Equal(t, newCommit, servedCommit(t, ctrl))
+
+		pinResp, err := ctrl.PinConfig(context.Background(), connect.NewRequest(&pbv1.PinConfigRequest{Commit: "aaaa"}))
+		require.NoError(t, err)
+		require.Equal(t, oldCommit, pinResp.Msg.Commit)
+		require.Equal(t, oldCommit, servedCommit(t, ctrl))
+
+		// Pushes and refreshes don't move a pinned config
+		push(t, ctrl, newCommit)
+		_, err = ctrl.ForceRefresh(context.Background(), connect.NewRequest(&pbv1.ForceRefreshRequest{}))
+		require.NoError(t, err)
+		require.Equal(t, oldCommit, servedCommit(t, ctrl))
+
+		listResp, err := ctrl.ListConfigRevisions(context.Background(), connect.NewRequest(&pbv1.ListConfigRevisionsRequest{}))
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.ListConfigRevisionsResponse{
+				Revisions: []*pbv1.ConfigRevision{
+					{Commit: oldCommit, LoadedAt: timestamppb.New(now), Trigger: pbv1.ConfigTrigger_CONFIG_TRIGGER_PIN},
+					{Commit: newCommit, LoadedAt: timestamppb.New(now), Trigger: pbv1.ConfigTrigger_CONFIG_TRIGGER_FORCE_REFRESH},
+					{Commit: oldCommit, LoadedAt: timestamppb.New(now), Trigger: pbv1.ConfigTrigger_CONFIG_TRIGGER_STARTUP},
+				},
+				CurrentCommit: oldCommit,
+				PinnedCommit:  oldCommit,
+			},
+			listResp.Msg,
+		)
+
+		unpinResp, err := ctrl.UnpinConfig(context.Background(), connect.NewRequest(&pbv1.UnpinConfigRequest{}))
+		require.NoError(t, err)
+		require.Equal(t, newCommit, unpinResp.Msg.Commit)
+		require.Equal(t, newCommit, servedCommit(t, ctrl))
+		require.Equal(t, ConfigTriggerUnpin, store.revisions[len(store.revisions)-1].Trigger)
+	})
+
+	t.Run("pin during a push wins", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, store := newRevisionController(t)
+		require.Equal(t, oldCommit, servedCommit(t, ctrl))
+
+		// Hold the push mid-load, and pin while it's stuck there
+		store.latest = newCommit
+		loading := make(chan struct{})
+		release := make(chan struct{})
+		store.cloneHook = func(commit string) {
+			if commit == newCommit {
+				close(loading)
+				<-release
+			}
+		}
+
+		pushDone := make(chan error, 1)
+		req := pushRequest(t, newCommit)
+		go func() {
+			pushDone <- ctrl.handleGithubWebhook(req)
+		}()
+		<-loading
+
+		pinDone := make(chan error, 1)
+		go func() {
+			_, err := ctrl.PinConfig(context.Background(), connect.NewRequest(&pbv1.PinConfigRequest{Commit: oldCommit}))
+			pinDone <- err
+		}()
+
+		// The pin has to wait for the push, give it the chance to not
+		select {
+		case err := <-pinDone:
+			pinDone <- err
+		case <-time.After(50 * time.Millisecond):
+		}
+		close(release)
+
+		require.NoError(t, <-pushDone)
+		require.NoError(t, <-pinDone)
+		require.Equal(t, oldCommit, servedCommit(t, ctrl))
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, store := newRevisionController(t)
+		require.Equal(t, oldCommit, servedCommit(t, ctrl))
+
+		lastPoll := func(t *testing.T) *pbv1.ConfigPoll {
+			t.Helper()
+
+			resp, err := ctrl.ListConfigRevisions(context.Background(), connect.NewRequest(&pbv1.ListConfigRevisionsRequest{}))
+			require.NoError(t, err)
+			return resp.Msg.LastPoll
+		}
+
+		require.Nil(t, lastPoll(t))
+
+		ctrl.pollOnce(context.Background())
+		pbEqual(t, &pbv1.ConfigPoll{PolledAt: timestamppb.New(now), Commit: oldCommit}, lastPoll(t))
+		require.Len(t, store.revisions, 1)
+
+		// The commit that was compared is the one loaded, without asking for it again
+		store.latest = newCommit
+		calls := store.latestCalls
+		ctrl.pollOnce(context.Background())
+		require.Equal(t, calls+1, store.latestCalls)
+		pbEqual(t, &pbv1.ConfigPoll{PolledAt: timestamppb.New(now), Commit: newCommit, Changed: true}, lastPoll(t))
+		require.Equal(t, newCommit, servedCommit(t, ctrl))
+		require.Equal(t, DBConfigRevision{Commit: newCommit, LoadedAt: now, Trigger: ConfigTriggerPoll}, store.revisions[len(store.revisions)-1])
+
+		// A pinned config isn't moved by polling
+		_, err := ctrl.PinConfig(context.Background(), connect.NewRequest(&pbv1.PinConfigRequest{Commit: oldCommit}))
+		require.NoError(t, err)
+		store.latest = "cccc3333"
+		ctrl.pollOnce(context.Background())
+		pbEqual(t, &pbv1.ConfigPoll{PolledAt: timestamppb.New(now), Commit: oldCommit}, lastPoll(t))
+	})
+
+	t.Run("polls straight away", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, store := newRevisionController(t)
+		ctrl.pollInterval = time.Hour
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go ctrl.Poll(ctx)
+
+		require.Eventually(t, func() bool {
+			return ctrl.lastPollPB() != nil
+		}, time.Second, time.Millisecond)
+		pbEqual(t, &pbv1.ConfigPoll{PolledAt: timestamppb.New(now), Commit: oldCommit, Changed: true}, ctrl.lastPollPB())
+
+		// Nothing was loaded before, so it's a normal startup load
+		require.Equal(t, []DBConfigRevision{{Commit: oldCommit, LoadedAt: now, Trigger: ConfigTriggerStartup}}, store.revisions)
+	})
+
+	t.Run("poll stops with context", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, _ := newRevisionController(t)
+		ctrl.pollInterval = time.Millisecond
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			ctrl.Poll(ctx)
+			close(done)
+		}()
+
+		require.Eventually(t, func() bool {
+			return ctrl.lastPollPB() != nil
+		}, time.Second, time.Millisecond)
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			require.FailNow(t, "poll didn't stop")
+		}
+	})
+
+	t.Run("pin validation", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, store := newRevisionController(t)
+		store.revisions = []DBConfigRevision{
+			{Commit: "abcd1111", Trigger: ConfigTriggerStartup},
+			{Commit: "abcd2222", Trigger: ConfigTriggerWebhook},
+		}
+
+		_, err := ctrl.PinConfig(context.Background(), connect.NewRequest(&pbv1.PinConfigRequest{}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = ctrl.PinConfig(context.Background(), connect.NewRequest(&pbv1.PinConfigRequest{Commit: "abcd"}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		require.ErrorIs(t, err, ErrAmbiguousCommitError)
+
+		for _, commit := range []string{"abc", "not-a-commit", strings.Repeat("a", 41)} {
+			_, err = ctrl.PinConfig(context.Background(), connect.NewRequest(&pbv1.PinConfigRequest{Commit: commit}))
+			require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), commit)
+			require.ErrorIs(t, err, ErrInvalidCommitError)
+		}
+
+		_, err = ctrl.PinConfig(context.Background(), connect.NewRequest(&pbv1.PinConfigRequest{Commit: "abcd3"}))
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+		require.Empty(t, store.pins)
+	})
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+)
+
+type signatureTemplateData struct {
+	// Name is the filename of the download
+	Name string
+	// Url is the full download url
+	Url string
+}
+
+// renderSignature resolves where the agent should fetch the signature for downloadUrl from. Release assets all live
+// alongside each other, so an asset name just replaces the last path segment of the download url
+func renderSignature(sig *parsingv2.Signature, downloadUrl string) (*pbv1.Signature, error) {
+	if sig == nil {
+		return nil, nil
+	}
+
+	data := signatureTemplateData{
+		Name: path.Base(downloadUrl),
+		Url:  downloadUrl,
+	}
+
+	var sigUrl string
+	if sig.Asset != "" {
+		name, err := renderSignatureTemplate(sig.Asset, data)
+		if err != nil {
+			return nil, err
+		}
+		sigUrl = downloadUrl[:strings.LastIndex(downloadUrl, "/")+1] + name
+	} else {
+		url, err := renderSignatureTemplate(sig.Url, data)
+		if err != nil {
+			return nil, err
+		}
+		sigUrl = url
+	}
+
+	return &pbv1.Signature{
+		Kind:      sig.Kind,
+		PublicKey: sig.PublicKey,
+		Url:       sigUrl,
+	}, nil
+}
+
+func renderSignatureTemplate(content string, data signatureTemplateData) (string, error) {
+	t, err := template.New("").Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("error parsing signature template: %w", err)
+	}
+
+	var buf strings.Builder
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("error rendering signature template: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"testing"
+
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSignature(t *testing.T) {
+	t.Parallel()
+
+	const downloadURL = "https://github.com/some/repo/releases/download/v1.0.0/some-linux-amd64.tar.gz"
+
+	testData := []struct {
+		name string
+		sig  *parsingv2.Signature
+		want *pbv1.Signature
+		err  string
+	}{
+		{
+			name: "unset",
+			sig:  nil,
+			want: nil,
+		},
+		{
+			name: "asset",
+			sig: &parsingv2.Signature{
+				Kind:      "minisign",
+				PublicKey: "some-key",
+				Asset:     "{{ .Name }}.minisig",
+			},
+			want: &pbv1.Signature{
+				Kind:      "minisign",
+				PublicKey: "some-key",
+				Url:       "https://github.com/some/repo/releases/download/v1.0.0/some-linux-amd64.tar.gz.minisig",
+			},
+		},
+		{
+			name: "static asset",
+			sig: &parsingv2.Signature{
+				Kind:      "openpgp",
+				PublicKey: "some-key",
+				Asset:     "SHA256SUMS.asc",
+			},
+			want: &pbv1.Signature{
+				Kind:      "openpgp",
+				PublicKey: "some-key",
+				Url:       "https://github.com/some/repo/releases/download/v1.0.0/SHA256SUMS.asc",
+			},
+		},
+		{
+			name: "url",
+			sig: &parsingv2.Signature{
+				Kind:      "openpgp",
+				PublicKey: "some-key",
+				Url:       "https://signatures.example.com/{{ .Name }}.asc",
+			},
+			want: &pbv1.Signature{
+				Kind:      "openpgp",
+				PublicKey: "some-key",
+				Url:       "https://signatures.example.com/some-linux-amd64.tar.gz.asc",
+			},
+		},
+		{
+			name: "unknown field",
+			sig: &parsingv2.Signature{
+				Kind:      "openpgp",
+				PublicKey: "some-key",
+				Url:       "{{ .Tag }}.asc",
+			},
+			err: "error rendering signature template",
+		},
+	}
+	for _, tc := range testData {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := renderSignature(tc.sig, downloadURL)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			pbEqual(t, tc.want, got)
+		})
+	}
+}
@@ -109,6 +109,7 @@ func TestController_Login(t *testing.T) {
 				ExpiresAt: now.Add(10 * 24 * time.Hour).Unix(),
 			},
 			NodeID: nodeID,
+			Role:   token.RoleNode,
 		}
 		require.Equal(t, wantToken, gotToken)
 	})
@@ -174,6 +175,76 @@ func TestController_GetSyncData(t *testing.T) {
 			got.Msg,
 		)
 	})
+
+	t.Run("only new seeds are sent", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			node = &parsingv2.Node{
+				ID:       nodeID,
+				Roles:    []string{"foo"},
+				UserHome: "/home/fake-user",
+			}
+			applied    = &parsingv2.Seed{Element: &parsingv2.Golang{Version: "1.23.1"}}
+			pinned     = &parsingv2.Seed{Element: &parsingv2.GoInstall{Package: "github.com/some/pinned", Version: hlp.Ptr("v1.0.0")}}
+			latest     = &parsingv2.Seed{Element: &parsingv2.GoInstall{Package: "github.com/some/latest"}}
+			notApplied = &parsingv2.Seed{Element: &parsingv2.GoInstall{Package: "github.com/some/new", Version: hlp.Ptr("v1.0.0")}}
+		)
+
+		hashOf := func(seed *parsingv2.Seed) string {
+			hash, err := seed.ComputeHash(node)
+			require.NoError(t, err)
+			return hash
+		}
+
+		ctrl := newControllerWithConfig(
+			t,
+			ControllerConfig{
+				VaultClient: NewNoopVault(NoopVaultConfig{}),
+			},
+			&parsingv2.Config{
+				Roles: map[string][]*parsingv2.Seed{
+					"foo": {applied, pinned, latest, notApplied},
+				},
+				Nodes: []*parsingv2.Node{node},
+			},
+		)
+
+		got, err := ctrl.GetSyncData(ctx, connect.NewRequest(&pbv1.GetSyncDataRequest{
+			InventoryHashes: []string{hashOf(applied), hashOf(pinned), hashOf(latest), "some-stale-hash"},
+		}))
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.GetSyncDataResponse{
+				Seeds: []*pbv1.Seed{
+					{
+						Metadata: &pbv1.Seed_Metadata{
+							DisplayName: "github.com/some/latest@latest",
+						},
+						Element: &pbv1.Seed_GoInstall{
+							GoInstall: &pbv1.GoInstall{
+								Package: "github.com/some/latest",
+							},
+						},
+					},
+					{
+						Metadata: &pbv1.Seed_Metadata{
+							DisplayName: "github.com/some/new@v1.0.0",
+						},
+						Element: &pbv1.Seed_GoInstall{
+							GoInstall: &pbv1.GoInstall{
+								Package: "github.com/some/new",
+								Version: hlp.Ptr("v1.0.0"),
+							},
+						},
+					},
+				},
+				RemovedHashes: []string{"some-stale-hash"},
+			},
+			got.Msg,
+		)
+	})
 }
 
 func TestValidateGithubRequest(t *testing.T) {
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+var (
+	ErrNoTrackedTagError = errors.New("no tag matching pattern")
+)
+
+// githubPushCommitLimit is the most commits github includes in a push payload, a push listing that many may have been
+// truncated
+const githubPushCommitLimit = 2048
+
+// latestCommit is the config repo commit the controller should be serving, either the head of the tracked branch or the
+// commit of the newest tag matching the tag pattern
+func (c *Controller) latestCommit() (string, error) {
+	if c.tagPattern == "" {
+		return c.git.GetLatestCommit(c.repoUrl)
+	}
+
+	tags, err := c.git.ListTags(c.repoUrl)
+	if err != nil {
+		return "", fmt.Errorf("error listing tags: %w", err)
+	}
+
+	var newest *GitTag
+	for _, tag := range tags {
+		if matched, _ := path.Match(c.tagPattern, tag.Name); !matched {
+			continue
+		}
+		if newest == nil || compareNatural(tag.Name, newest.Name) > 0 {
+			newest = &tag
+		}
+	}
+	if newest == nil {
+		return "", fmt.Errorf("%w %q", ErrNoTrackedTagError, c.tagPattern)
+	}
+
+	c.log.Trace().Msgf("tracking tag %v", newest.Name)
+	return newest.Commit, nil
+}
+
+// tracksRef reports if a push to ref changes what the controller should be serving. defaultBranch is used when no
+// branch is configured
+func (c *Controller) tracksRef(ref string, defaultBranch string) bool {
+	if c.tagPattern != "" {
+		name, ok := strings.CutPrefix(ref, "refs/tags/")
+		if !ok {
+			return false
+		}
+		matched, _ := path.Match(c.tagPattern, name)
+		return matched
+	}
+
+	branch := c.branch
+	if branch == "" {
+		branch = defaultBranch
+	}
+	return ref == "refs/heads/"+branch
+}
+
+// compareNatural orders strings with runs of digits compared by value, so release-10 sorts after release-9 and dated
+// tags sort chronologically
+func compareNatural(a string, b string) int {
+	for a != "" && b != "" {
+		aDigits, bDigits := leadingDigits(a), leadingDigits(b)
+		if aDigits == "" || bDigits == "" {
+			if a[0] != b[0] {
+				return cmp.Compare(a[0], b[0])
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+
+		aValue, bValue := strings.TrimLeft(aDigits, "0"), strings.TrimLeft(bDigits, "0")
+		if len(aValue) != len(bValue) {
+			return cmp.Compare(len(aValue), len(bValue))
+		}
+		if c := strings.Compare(aValue, bValue); c != 0 {
+			return c
+		}
+		a, b = a[len(aDigits):], b[len(bDigits):]
+	}
+
+	return cmp.Compare(len(a), len(b))
+}
+
+func leadingDigits(s string) string {
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if end == -1 {
+		return s
+	}
+	return s[:end]
+}
+
+// skipPush reports if a push to the tracked branch can be skipped because none of its commits touch the config path.
+// If so the default channel has been moved along to the pushed commit without reloading it
+func (c *Controller) skipPush(ctx context.Context, push githubPushBody) bool {
+	// Force pushes can drop commits that did touch it, and the commits aren't listed for every push
+	if c.configPath == "." || push.Forced || len(push.Commits) == 0 || len(push.Commits) >= githubPushCommitLimit {
+		return false
+	}
+	for _, commit := range push.Commits {
+		for _, files := range [][]string{commit.Added, commit.Removed, commit.Modified} {
+			for _, file := range files {
+				if c.inConfigPath(file) {
+					return false
+				}
+			}
+		}
+	}
+
+	// Only the pushed commits are listed, anything between them and what's loaded could have changed the config
+	if !c.advanceConfig(ctx, push.Before, push.After, ConfigTriggerWebhook) {
+		return false
+	}
+
+	c.log.Info().Msgf("push to %v doesn't touch %v, moved to %v without reloading", push.Ref, c.configPath, push.After)
+	return true
+}
+
+// inConfigPath reports if the repo file is under the config path
+func (c *Controller) inConfigPath(file string) bool {
+	return c.configPath == "." || file == c.configPath || strings.HasPrefix(file, c.configPath+"/")
+}
@@ -0,0 +1,298 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"testing"
+	"testing/fstest"
+
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareNatural(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "equal", a: "release-1", b: "release-1", want: 0},
+		{name: "numeric runs by value", a: "release-10", b: "release-9", want: 1},
+		{name: "dated", a: "release-2024-09-30", b: "release-2024-10-01", want: -1},
+		{name: "leading zeros", a: "release-007", b: "release-7", want: 0},
+		{name: "text", a: "release-b", b: "release-a", want: 1},
+		{name: "prefix", a: "release-1", b: "release-1.1", want: -1},
+	}
+	for _, tc := range testData {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, compareNatural(tc.a, tc.b))
+			require.Equal(t, -tc.want, compareNatural(tc.b, tc.a))
+		})
+	}
+}
+
+func TestController_TracksRef(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default branch", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{}, nil)
+		require.True(t, ctrl.tracksRef("refs/heads/main", "main"))
+		require.True(t, ctrl.tracksRef("refs/heads/trunk", "trunk"))
+		require.False(t, ctrl.tracksRef("refs/heads/feature", "main"))
+		require.False(t, ctrl.tracksRef("refs/tags/release-1", "main"))
+	})
+
+	t.Run("configured branch", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{Branch: "deploy"}, nil)
+		require.True(t, ctrl.tracksRef("refs/heads/deploy", "main"))
+		require.False(t, ctrl.tracksRef("refs/heads/main", "main"))
+	})
+
+	t.Run("tag pattern", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{TagPattern: "release-*"}, nil)
+		require.True(t, ctrl.tracksRef("refs/tags/release-1", "main"))
+		require.False(t, ctrl.tracksRef("refs/tags/v1.0.0", "main"))
+		require.False(t, ctrl.tracksRef("refs/heads/main", "main"))
+	})
+
+	t.Run("invalid tag pattern", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewController(ControllerConfig{TagPattern: "release-["})
+		require.Error(t, err)
+	})
+}
+
+func TestController_LatestCommit(t *testing.T) {
+	t.Parallel()
+
+	const repoUrl = "https://github.com/some/config.git"
+
+	t.Run("branch", func(t *testing.T) {
+		t.Parallel()
+
+		gitClient := NewMockGitClient(t)
+		gitClient.EXPECT().GetLatestCommit(repoUrl).Return("some-head", nil)
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{GitClient: gitClient, RepoURL: repoUrl}, nil)
+		commit, err := ctrl.latestCommit()
+		require.NoError(t, err)
+		require.Equal(t, "some-head", commit)
+	})
+
+	t.Run("tag pattern", func(t *testing.T) {
+		t.Parallel()
+
+		gitClient := NewMockGitClient(t)
+		gitClient.EXPECT().ListTags(repoUrl).Return(
+			[]GitTag{
+				{Name: "release-10", Commit: "commit-10"},
+				{Name: "release-9", Commit: "commit-9"},
+				{Name: "v2.0.0", Commit: "commit-v2"},
+			},
+			nil,
+		)
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{GitClient: gitClient, RepoURL: repoUrl, TagPattern: "release-*"}, nil)
+		commit, err := ctrl.latestCommit()
+		require.NoError(t, err)
+		require.Equal(t, "commit-10", commit)
+	})
+
+	t.Run("no matching tags", func(t *testing.T) {
+		t.Parallel()
+
+		gitClient := NewMockGitClient(t)
+		gitClient.EXPECT().ListTags(repoUrl).Return([]GitTag{{Name: "v2.0.0", Commit: "commit-v2"}}, nil)
+
+		ctrl := newControllerWithConfig(t, ControllerConfig{GitClient: gitClient, RepoURL: repoUrl, TagPattern: "release-*"}, nil)
+		_, err := ctrl.latestCommit()
+		require.ErrorIs(t, err, ErrNoTrackedTagError)
+	})
+}
+
+func TestController_ConfigPath(t *testing.T) {
+	t.Parallel()
+
+	const (
+		repoUrl    = "https://github.com/some/monorepo.git"
+		oldCommit  = "aaaa1111"
+		newCommit  = "bbbb2222"
+		nextCommit = "cccc3333"
+	)
+
+	webhookSecret := []byte("some-webhook-secret")
+
+	monorepo := func(pkg string) fs.FS {
+		return fstest.MapFS{
+			"machines/plantr/plantr.yaml": channelRepo(pkg).(fstest.MapFS)["plantr.yaml"],
+			"README.md":                   &fstest.MapFile{Data: []byte("# Infra")},
+		}
+	}
+
+	newPathController := func(t *testing.T) (*Controller, *int) {
+		t.Helper()
+
+		repos := map[string]fs.FS{
+			oldCommit:  monorepo("old-pkg"),
+			nextCommit: monorepo("next-pkg"),
+		}
+		clones := 0
+
+		gitClient := NewMockGitClient(t)
+		gitClient.EXPECT().GetLatestCommit(repoUrl).Return(oldCommit, nil).Maybe()
+		gitClient.EXPECT().CloneAtCommit(repoUrl, mock.Anything).RunAndReturn(func(_ string, commit string) (fs.FS, error) {
+			clones++
+			return repos[commit], nil
+		}).Maybe()
+
+		storage := NewMockStorageClient(t)
+		storage.EXPECT().ReadChannelPin(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+		storage.EXPECT().WriteConfigRevision(mock.Anything, mock.Anything).Return(nil).Maybe()
+		storage.EXPECT().PruneConfigRevisions(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+		ctrl := newControllerWithConfig(
+			t,
+			ControllerConfig{
+				GitClient:           gitClient,
+				StorageClient:       storage,
+				RepoURL:             repoUrl,
+				GithubWebhookSecret: webhookSecret,
+				Path:                "/machines/plantr/",
+			},
+			nil,
+		)
+
+		return ctrl, &clones
+	}
+
+	push := func(t *testing.T, ctrl *Controller, body githubPushBody) {
+		t.Helper()
+
+		body.Ref = "refs/heads/main"
+		body.Repository.DefaultBranch = "main"
+		payload, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		mac := hmac.New(sha256.New, webhookSecret)
+		mac.Write(payload)
+
+		req, err := http.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(payload))
+		require.NoError(t, err)
+		req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		require.NoError(t, ctrl.handleGithubWebhook(req))
+	}
+
+	served := func(t *testing.T, ctrl *Controller) (string, string) {
+		t.Helper()
+
+		seeds, _, commit, err := ctrl.collectSeeds(context.Background(), "default-node")
+		require.NoError(t, err)
+		require.Len(t, seeds, 1)
+		return seeds[0].Element.(*parsingv2.SystemPackage).Apt.Name, commit
+	}
+
+	t.Run("parses from the path", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, _ := newPathController(t)
+		require.Equal(t, "machines/plantr", ctrl.configPath)
+
+		pkg, commit := served(t, ctrl)
+		require.Equal(t, "old-pkg", pkg)
+		require.Equal(t, oldCommit, commit)
+	})
+
+	t.Run("skips pushes outside the path", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, clones := newPathController(t)
+		_, _ = served(t, ctrl)
+		require.Equal(t, 1, *clones)
+
+		push(t, ctrl, githubPushBody{
+			Before:  oldCommit,
+			After:   newCommit,
+			Commits: []githubPushCommit{{Modified: []string{"README.md", "machines/other/thing.yaml"}}},
+		})
+		pkg, commit := served(t, ctrl)
+		require.Equal(t, "old-pkg", pkg)
+		require.Equal(t, newCommit, commit)
+		require.Equal(t, 1, *clones)
+
+		push(t, ctrl, githubPushBody{
+			Before:  newCommit,
+			After:   nextCommit,
+			Commits: []githubPushCommit{{Modified: []string{"machines/plantr/plantr.yaml"}}},
+		})
+		pkg, commit = served(t, ctrl)
+		require.Equal(t, "next-pkg", pkg)
+		require.Equal(t, nextCommit, commit)
+		require.Equal(t, 2, *clones)
+	})
+
+	t.Run("doesn't move a config that was reloaded", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl, _ := newPathController(t)
+		_, _ = served(t, ctrl)
+
+		// A poll or another push got there first
+		require.True(t, ctrl.advanceConfig(context.Background(), oldCommit, nextCommit, ConfigTriggerPoll))
+		require.False(t, ctrl.advanceConfig(context.Background(), oldCommit, newCommit, ConfigTriggerWebhook))
+		require.Equal(t, nextCommit, ctrl.currentCommit())
+	})
+
+	t.Run("reloads pushes it can't vouch for", func(t *testing.T) {
+		t.Parallel()
+
+		testData := []struct {
+			name string
+			body githubPushBody
+		}{
+			{
+				name: "forced",
+				body: githubPushBody{Before: oldCommit, Forced: true, Commits: []githubPushCommit{{Modified: []string{"README.md"}}}},
+			},
+			{
+				name: "no commits",
+				body: githubPushBody{Before: oldCommit},
+			},
+			{
+				name: "not from the loaded commit",
+				body: githubPushBody{Before: newCommit, Commits: []githubPushCommit{{Modified: []string{"README.md"}}}},
+			},
+		}
+		for _, tc := range testData {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				ctrl, clones := newPathController(t)
+				_, _ = served(t, ctrl)
+
+				tc.body.After = nextCommit
+				push(t, ctrl, tc.body)
+				pkg, _ := served(t, ctrl)
+				require.Equal(t, "next-pkg", pkg)
+				require.Equal(t, 2, *clones)
+			})
+		}
+	})
+}
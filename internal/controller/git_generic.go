@@ -0,0 +1,330 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/helper/iofs"
+	gmemfs "github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	ghttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/rs/zerolog"
+)
+
+var (
+	ErrNoRemoteHeadError = errors.New("remote has no HEAD")
+	ErrNoRemoteTagsError = errors.New("remote has no release tags")
+)
+
+type GenericGitClientConfig struct {
+	Logger zerolog.Logger
+	// RepoURL is the config repo, credentials are only sent to remotes on the same host
+	RepoURL string
+	// Branch to track, defaults to the remote's default branch
+	Branch string
+	// Username for basic or SSH auth, defaults to git
+	Username string
+	// Password enables basic auth over HTTPS, typically an access token
+	Password string
+	// SSHKeyPath enables SSH auth with the given private key, i.e a deploy key
+	SSHKeyPath       string
+	SSHKeyPassphrase string
+	// KnownHostsPath verifies SSH host keys, defaults to $SSH_KNOWN_HOSTS or ~/.ssh/known_hosts
+	KnownHostsPath string
+}
+
+func NewGenericGitClient(conf GenericGitClientConfig) (*GenericGitClient, error) {
+	username := conf.Username
+	if username == "" {
+		username = DefaultGitUsername
+	}
+
+	var auth transport.AuthMethod
+	switch {
+	case conf.SSHKeyPath != "":
+		keys, err := gssh.NewPublicKeysFromFile(username, conf.SSHKeyPath, conf.SSHKeyPassphrase)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ssh key: %w", err)
+		}
+		knownHosts := []string{}
+		if conf.KnownHostsPath != "" {
+			knownHosts = append(knownHosts, conf.KnownHostsPath)
+		}
+		keys.HostKeyCallback, err = gssh.NewKnownHostsCallback(knownHosts...)
+		if err != nil {
+			return nil, fmt.Errorf("error reading known hosts: %w", err)
+		}
+		auth = keys
+	case conf.Password != "":
+		auth = &ghttp.BasicAuth{
+			Username: username,
+			Password: conf.Password,
+		}
+	}
+
+	return &GenericGitClient{
+		log:     conf.Logger,
+		repoURL: conf.RepoURL,
+		branch:  conf.Branch,
+		auth:    auth,
+	}, nil
+}
+
+var _ GitClient = (*GenericGitClient)(nil)
+
+// GenericGitClient talks to any git remote using only the git protocol, no provider API
+type GenericGitClient struct {
+	log     zerolog.Logger
+	repoURL string
+	branch  string
+	auth    transport.AuthMethod
+}
+
+// authFor returns the configured credentials if url is on the same host as the config repo. Other remotes, i.e
+// git_repo seeds being checked for updates, are listed anonymously so the credentials never leave the config repo host
+func (g *GenericGitClient) authFor(url string) transport.AuthMethod {
+	if g.auth == nil || !sameRemoteHost(url, g.repoURL) {
+		return nil
+	}
+	return g.auth
+}
+
+// sameRemoteHost reports if both remotes use the same protocol, host and port. scp style ssh urls are understood
+func sameRemoteHost(a string, b string) bool {
+	aEndpoint, err := transport.NewEndpoint(a)
+	if err != nil {
+		return false
+	}
+	bEndpoint, err := transport.NewEndpoint(b)
+	if err != nil {
+		return false
+	}
+	return aEndpoint.Protocol == bEndpoint.Protocol &&
+		strings.EqualFold(aEndpoint.Host, bEndpoint.Host) &&
+		endpointPort(aEndpoint) == endpointPort(bEndpoint)
+}
+
+// endpointPort fills in the protocol's default port, scp style urls come back with one set while ssh:// urls don't
+func endpointPort(endpoint *transport.Endpoint) int {
+	if endpoint.Port != 0 {
+		return endpoint.Port
+	}
+	switch endpoint.Protocol {
+	case "http":
+		return 80
+	case "https":
+		return 443
+	case "ssh":
+		return 22
+	case "git":
+		return 9418
+	default:
+		return 0
+	}
+}
+
+func (g *GenericGitClient) GetLatestCommit(url string) (string, error) {
+	return g.GetBranchCommit(url, g.branch)
+}
+
+func (g *GenericGitClient) GetBranchCommit(url string, branch string) (string, error) {
+	refs, err := listRemote(url, g.authFor(url))
+	if err != nil {
+		return "", err
+	}
+
+	byName := map[plumbing.ReferenceName]*plumbing.Reference{}
+	for _, ref := range refs {
+		byName[ref.Name()] = ref
+	}
+
+	// HEAD is usually advertised as a symbolic ref to the default branch
+	name := plumbing.HEAD
+	if branch != "" {
+		name = plumbing.NewBranchReferenceName(branch)
+	}
+	head, ok := byName[name]
+	for ok && head.Type() == plumbing.SymbolicReference {
+		head, ok = byName[head.Target()]
+	}
+	if !ok {
+		return "", fmt.Errorf("%w: %v", ErrNoRemoteHeadError, name)
+	}
+
+	return head.Hash().String(), nil
+}
+
+func (g *GenericGitClient) CloneAtCommit(url string, commit string) (fs.FS, error) {
+	return cloneAtCommit(url, g.branch, commit, g.authFor(url))
+}
+
+func (g *GenericGitClient) ListTags(url string) ([]GitTag, error) {
+	return listRemoteTags(url, g.authFor(url))
+}
+
+// GetLatestRelease returns the highest semver tag on the remote, ignoring prereleases and tags that aren't versions
+func (g *GenericGitClient) GetLatestRelease(url string) (string, error) {
+	remoteTags, err := listRemoteTags(url, g.authFor(url))
+	if err != nil {
+		return "", err
+	}
+
+	type versionedTag struct {
+		tag     string
+		version *semver.Version
+	}
+
+	tags := []versionedTag{}
+	for _, tag := range remoteTags {
+		version, err := semver.NewVersion(tag.Name)
+		if err != nil || version.Prerelease() != "" {
+			continue
+		}
+		tags = append(tags, versionedTag{tag: tag.Name, version: version})
+	}
+	if len(tags) == 0 {
+		return "", ErrNoRemoteTagsError
+	}
+
+	latest := slices.MaxFunc(tags, func(a versionedTag, b versionedTag) int {
+		return a.version.Compare(b.version)
+	})
+
+	return latest.tag, nil
+}
+
+// listRemote is the equivalent of git ls-remote, including the commits annotated tags peel to
+func listRemote(url string, auth transport.AuthMethod) ([]*plumbing.Reference, error) {
+	remote := git.NewRemote(memory.NewStorage(), &gitconfig.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+	})
+	refs, err := remote.List(&git.ListOptions{Auth: auth, PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return nil, fmt.Errorf("error listing remote: %w", err)
+	}
+	return refs, nil
+}
+
+// listRemoteTags lists the tags on the remote, sorted by name, with annotated tags resolved to their commit
+func listRemoteTags(url string, auth transport.AuthMethod) ([]GitTag, error) {
+	refs, err := listRemote(url, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := map[string]string{}
+	peeled := map[string]string{}
+	for _, ref := range refs {
+		if !ref.Name().IsTag() {
+			continue
+		}
+		if name, ok := strings.CutSuffix(ref.Name().Short(), "^{}"); ok {
+			peeled[name] = ref.Hash().String()
+		} else {
+			commits[ref.Name().Short()] = ref.Hash().String()
+		}
+	}
+	maps.Copy(commits, peeled)
+
+	tags := make([]GitTag, 0, len(commits))
+	for name, commit := range commits {
+		tags = append(tags, GitTag{Name: name, Commit: commit})
+	}
+	slices.SortFunc(tags, func(a GitTag, b GitTag) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return tags, nil
+}
+
+// cloneAtCommit does an in-memory clone of url, checked out at commit. The clone is shallow, falling back to the full
+// history of the branch when commit isn't its head, i.e when tracking tags, and then to every branch, i.e for a
+// channel on another branch
+func cloneAtCommit(url string, branch string, commit string, auth transport.AuthMethod) (fs.FS, error) {
+	type cloneAttempt struct {
+		depth   int
+		branch  string
+		allRefs bool
+	}
+	// The commit is usually the head of the branch, failing that somewhere in its history. Tags matched by a pattern
+	// can be on commits no branch reaches though, so the last resort fetches every ref the remote has
+	attempts := []cloneAttempt{{depth: 1, branch: branch}}
+	if branch != "" {
+		attempts = append(attempts, cloneAttempt{depth: 0, branch: branch})
+	}
+	attempts = append(attempts, cloneAttempt{allRefs: true})
+
+	for i, attempt := range attempts {
+		bfs := gmemfs.New()
+
+		var r *git.Repository
+		var err error
+		if attempt.allRefs {
+			r, err = fetchAllRefs(url, bfs, auth)
+		} else {
+			opts := &git.CloneOptions{
+				Auth:  auth,
+				URL:   url,
+				Depth: attempt.depth,
+			}
+			if attempt.branch != "" {
+				opts.ReferenceName = plumbing.NewBranchReferenceName(attempt.branch)
+				opts.SingleBranch = true
+			}
+			r, err = git.Clone(memory.NewStorage(), bfs, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error cloning: %w", err)
+		}
+
+		w, err := r.Worktree()
+		if err != nil {
+			return nil, fmt.Errorf("error getting worktree: %w", err)
+		}
+
+		err = w.Checkout(&git.CheckoutOptions{Hash: plumbing.NewHash(commit)})
+		if errors.Is(err, plumbing.ErrObjectNotFound) && i != len(attempts)-1 {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error checking out commit: %w", err)
+		}
+
+		return iofs.New(bfs), nil
+	}
+
+	return nil, fmt.Errorf("error checking out commit: %w", plumbing.ErrObjectNotFound)
+}
+
+// fetchAllRefs fetches every ref of the remote, not just its branches and the tags that point into them
+func fetchAllRefs(url string, bfs billy.Filesystem, auth transport.AuthMethod) (*git.Repository, error) {
+	r, err := git.Init(memory.NewStorage(), bfs)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing repo: %w", err)
+	}
+	remote, err := r.CreateRemote(&gitconfig.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{url}})
+	if err != nil {
+		return nil, fmt.Errorf("error adding remote: %w", err)
+	}
+	err = remote.Fetch(&git.FetchOptions{
+		Auth:     auth,
+		RefSpecs: []gitconfig.RefSpec{"+refs/*:refs/remotes/" + git.DefaultRemoteName + "/*"},
+		Tags:     git.NoTags,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil, err
+	}
+	return r, nil
+}
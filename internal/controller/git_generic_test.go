@@ -0,0 +1,291 @@
+package controller
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	ghttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// newBareRemote creates a bare repo on disk with a commit per file and the given tags on the last commit, returning
+// its path and the hash of the last commit
+func newBareRemote(t *testing.T, files map[string]string, tags ...string) (string, string) {
+	t.Helper()
+
+	workDir := filepath.Join(t.TempDir(), "work")
+	repo, err := git.PlainInit(workDir, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	var head plumbing.Hash
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, name), []byte(content), 0644))
+		_, err := worktree.Add(name)
+		require.NoError(t, err)
+		head, err = worktree.Commit("add "+name, &git.CommitOptions{
+			Author: &object.Signature{Name: "some-author", Email: "author@example.com", When: time.Now()},
+		})
+		require.NoError(t, err)
+	}
+	for _, tag := range tags {
+		_, err := repo.CreateTag(tag, head, nil)
+		require.NoError(t, err)
+	}
+
+	remoteDir := filepath.Join(t.TempDir(), "remote.git")
+	_, err = git.PlainClone(remoteDir, true, &git.CloneOptions{URL: workDir, Tags: git.AllTags})
+	require.NoError(t, err)
+
+	return remoteDir, head.String()
+}
+
+func TestGenericGitClient(t *testing.T) {
+	t.Parallel()
+
+	remote, head := newBareRemote(
+		t,
+		map[string]string{"plantr.yaml": "some-config"},
+		"v1.0.0", "v1.10.0", "v1.9.0", "v2.0.0-rc1", "not-a-version",
+	)
+
+	client, err := NewGenericGitClient(GenericGitClientConfig{})
+	require.NoError(t, err)
+
+	t.Run("latest commit", func(t *testing.T) {
+		t.Parallel()
+
+		commit, err := client.GetLatestCommit(remote)
+		require.NoError(t, err)
+		require.Equal(t, head, commit)
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		t.Parallel()
+
+		fsys, err := client.CloneAtCommit(remote, head)
+		require.NoError(t, err)
+		content, err := fs.ReadFile(fsys, "plantr.yaml")
+		require.NoError(t, err)
+		require.Equal(t, "some-config", string(content))
+	})
+
+	t.Run("latest release", func(t *testing.T) {
+		t.Parallel()
+
+		tag, err := client.GetLatestRelease(remote)
+		require.NoError(t, err)
+		require.Equal(t, "v1.10.0", tag)
+	})
+
+	t.Run("no release tags", func(t *testing.T) {
+		t.Parallel()
+
+		untagged, _ := newBareRemote(t, map[string]string{"README.md": "hi"})
+		_, err := client.GetLatestRelease(untagged)
+		require.ErrorIs(t, err, ErrNoRemoteTagsError)
+	})
+
+	t.Run("missing remote", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := client.GetLatestCommit(filepath.Join(t.TempDir(), "nope.git"))
+		require.Error(t, err)
+	})
+}
+
+func TestGenericGitClient_Tracking(t *testing.T) {
+	t.Parallel()
+
+	workDir := filepath.Join(t.TempDir(), "work")
+	repo, err := git.PlainInit(workDir, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	signature := &object.Signature{Name: "some-author", Email: "author@example.com", When: time.Now()}
+	commit := func(content string) plumbing.Hash {
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, "plantr.yaml"), []byte(content), 0644))
+		_, err := worktree.Add("plantr.yaml")
+		require.NoError(t, err)
+		hash, err := worktree.Commit("update config", &git.CommitOptions{Author: signature})
+		require.NoError(t, err)
+		return hash
+	}
+
+	released := commit("released-config")
+	_, err = repo.CreateTag("release-1", released, &git.CreateTagOptions{Message: "release 1", Tagger: signature})
+	require.NoError(t, err)
+	head := commit("unreleased-config")
+
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	_, err = git.PlainClone(remote, true, &git.CloneOptions{URL: workDir, Tags: git.AllTags})
+	require.NoError(t, err)
+
+	branch := "master"
+	client, err := NewGenericGitClient(GenericGitClientConfig{Branch: branch})
+	require.NoError(t, err)
+
+	t.Run("latest commit on branch", func(t *testing.T) {
+		t.Parallel()
+
+		latest, err := client.GetLatestCommit(remote)
+		require.NoError(t, err)
+		require.Equal(t, head.String(), latest)
+	})
+
+	t.Run("missing branch", func(t *testing.T) {
+		t.Parallel()
+
+		other, err := NewGenericGitClient(GenericGitClientConfig{Branch: "nope"})
+		require.NoError(t, err)
+		_, err = other.GetLatestCommit(remote)
+		require.ErrorIs(t, err, ErrNoRemoteHeadError)
+	})
+
+	t.Run("annotated tags resolve to commits", func(t *testing.T) {
+		t.Parallel()
+
+		tags, err := client.ListTags(remote)
+		require.NoError(t, err)
+		require.Equal(t, []GitTag{{Name: "release-1", Commit: released.String()}}, tags)
+	})
+
+	t.Run("clone behind the branch head", func(t *testing.T) {
+		t.Parallel()
+
+		fsys, err := client.CloneAtCommit(remote, released.String())
+		require.NoError(t, err)
+		content, err := fs.ReadFile(fsys, "plantr.yaml")
+		require.NoError(t, err)
+		require.Equal(t, "released-config", string(content))
+	})
+}
+
+func TestGenericGitClient_CloneOffBranch(t *testing.T) {
+	t.Parallel()
+
+	workDir := filepath.Join(t.TempDir(), "work")
+	repo, err := git.PlainInit(workDir, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	signature := &object.Signature{Name: "some-author", Email: "author@example.com", When: time.Now()}
+	commit := func(content string) plumbing.Hash {
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, "plantr.yaml"), []byte(content), 0644))
+		_, err := worktree.Add("plantr.yaml")
+		require.NoError(t, err)
+		hash, err := worktree.Commit("update config", &git.CommitOptions{Author: signature})
+		require.NoError(t, err)
+		return hash
+	}
+
+	commit("default-config")
+	head, err := repo.Head()
+	require.NoError(t, err)
+
+	// A release cut from a branch that's since been deleted, leaving only the tag
+	side := plumbing.NewBranchReferenceName("release-branch")
+	require.NoError(t, worktree.Checkout(&git.CheckoutOptions{Branch: side, Create: true}))
+	tagged := commit("tagged-config")
+	_, err = repo.CreateTag("release-1", tagged, nil)
+	require.NoError(t, err)
+	// And one only reachable through a ref that's neither a branch nor a tag
+	unbranched := commit("unbranched-config")
+	require.NoError(t, worktree.Checkout(&git.CheckoutOptions{Branch: head.Name()}))
+	require.NoError(t, repo.Storer.RemoveReference(side))
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference("refs/pull/1/head", unbranched)))
+
+	client, err := NewGenericGitClient(GenericGitClientConfig{})
+	require.NoError(t, err)
+
+	for commit, want := range map[plumbing.Hash]string{tagged: "tagged-config", unbranched: "unbranched-config"} {
+		fsys, err := client.CloneAtCommit(workDir, commit.String())
+		require.NoError(t, err)
+		content, err := fs.ReadFile(fsys, "plantr.yaml")
+		require.NoError(t, err)
+		require.Equal(t, want, string(content))
+	}
+}
+
+func TestNewGenericGitClient_Auth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewGenericGitClient(GenericGitClientConfig{Password: "some-token"})
+		require.NoError(t, err)
+		require.Equal(t, &ghttp.BasicAuth{Username: "git", Password: "some-token"}, client.auth)
+	})
+
+	t.Run("ssh", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		block, err := ssh.MarshalPrivateKey(key, "")
+		require.NoError(t, err)
+		keyPath := filepath.Join(dir, "id_ed25519")
+		require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(block), 0600))
+
+		hostPub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		hostKey, err := ssh.NewPublicKey(hostPub)
+		require.NoError(t, err)
+		knownHostsPath := filepath.Join(dir, "known_hosts")
+		require.NoError(t, os.WriteFile(knownHostsPath, []byte("git.example.com "+string(ssh.MarshalAuthorizedKey(hostKey))), 0600))
+
+		client, err := NewGenericGitClient(GenericGitClientConfig{
+			Username:       "deploy",
+			SSHKeyPath:     keyPath,
+			KnownHostsPath: knownHostsPath,
+		})
+		require.NoError(t, err)
+
+		keys, ok := client.auth.(*gssh.PublicKeys)
+		require.True(t, ok)
+		require.Equal(t, "deploy", keys.User)
+		addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 22}
+		require.NoError(t, keys.HostKeyCallback("git.example.com:22", addr, hostKey))
+		require.Error(t, keys.HostKeyCallback("other.example.com:22", addr, hostKey))
+	})
+}
+
+func TestGenericGitClient_AuthFor(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewGenericGitClient(GenericGitClientConfig{
+		RepoURL:  "https://git.example.com/infra/plantr-config.git",
+		Password: "some-token",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, client.auth, client.authFor("https://git.example.com/infra/plantr-config.git"))
+	require.Equal(t, client.auth, client.authFor("https://GIT.example.com/infra/other.git"))
+	require.Nil(t, client.authFor("https://github.com/some/tool.git"))
+	require.Nil(t, client.authFor("http://git.example.com/infra/plantr-config.git"))
+	require.Nil(t, client.authFor("https://git.example.com:8443/infra/plantr-config.git"))
+
+	sshClient, err := NewGenericGitClient(GenericGitClientConfig{RepoURL: "git@git.example.com:infra/plantr-config.git"})
+	require.NoError(t, err)
+	sshClient.auth = &ghttp.BasicAuth{Username: "git", Password: "stand-in"}
+	require.NotNil(t, sshClient.authFor("ssh://git@git.example.com/infra/other.git"))
+	require.Nil(t, sshClient.authFor("git@github.com:some/tool.git"))
+}
@@ -3,18 +3,31 @@ package controller
 import (
 	"fmt"
 	"io/fs"
+	"net/http"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 )
 
 type GitClient interface {
+	// GetLatestCommit returns the head of the configured branch, or the remote's default branch if there isn't one
 	GetLatestCommit(url string) (string, error)
+	// GetBranchCommit returns the head of the given branch, or the remote's default branch if branch is empty
+	GetBranchCommit(url string, branch string) (string, error)
 	CloneAtCommit(url string, commit string) (fs.FS, error)
 	GetLatestRelease(url string) (string, error)
+	// ListTags returns every tag on the remote, with annotated tags resolved to the commit they point at
+	ListTags(url string) ([]GitTag, error)
 }
 
-func NewGitFromEnv(logger zerolog.Logger) (GitClient, error) {
+type GitTag struct {
+	Name   string
+	Commit string
+}
+
+// NewGitFromEnv creates the configured git client. tokenSource, if non-nil, is used in place of the git access token.
+// githubTransport, if non-nil, makes the GitHub API requests
+func NewGitFromEnv(logger zerolog.Logger, tokenSource GithubTokenSource, githubTransport http.RoundTripper) (GitClient, error) {
 	kind, err := ParseGitKind(viper.GetString(GitType))
 	if err != nil {
 		return nil, err
@@ -23,13 +36,33 @@ func NewGitFromEnv(logger zerolog.Logger) (GitClient, error) {
 	switch kind {
 	case GitKindGithub:
 		gh, err := NewGithubGitClient(GithubGitClientConfig{
-			Logger: logger,
-			Token:  viper.GetString(GitAccessToken),
+			Logger:      logger,
+			Token:       viper.GetString(GitAccessToken),
+			TokenSource: tokenSource,
+			Branch:      viper.GetString(GitBranch),
+			ApiURL:      viper.GetString(GithubApiUrl),
+			Hostname:    viper.GetString(GithubHostname),
+			Transport:   githubTransport,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("error initializing GitHub client: %w", err)
 		}
 		return gh, nil
+	case GitKindGit:
+		g, err := NewGenericGitClient(GenericGitClientConfig{
+			Logger:           logger,
+			RepoURL:          viper.GetString(GitUrl),
+			Branch:           viper.GetString(GitBranch),
+			Username:         viper.GetString(GitUsername),
+			Password:         viper.GetString(GitAccessToken),
+			SSHKeyPath:       viper.GetString(GitSSHPrivateKeyPath),
+			SSHKeyPassphrase: viper.GetString(GitSSHPrivateKeyPassphrase),
+			KnownHostsPath:   viper.GetString(GitSSHKnownHostsPath),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error initializing git client: %w", err)
+		}
+		return g, nil
 	case GitKindStatic:
 		s, err := NewStaticGitClient(StaticGitClientConfig{
 			Logger:       logger,
@@ -8,12 +8,7 @@ import (
 	"regexp"
 
 	"github.com/carlmjohnson/requests"
-	"github.com/go-git/go-billy/v5/helper/iofs"
-	gmemfs "github.com/go-git/go-billy/v5/memfs"
-	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/plumbing"
 	ghttp "github.com/go-git/go-git/v5/plumbing/transport/http"
-	"github.com/go-git/go-git/v5/storage/memory"
 	"github.com/nicjohnson145/hlp"
 	"github.com/rs/zerolog"
 )
@@ -21,17 +16,50 @@ import (
 type GithubGitClientConfig struct {
 	Logger zerolog.Logger
 	Token  string
+	// TokenSource takes precedence over Token, i.e when authenticating as a GitHub App
+	TokenSource GithubTokenSource
+	// Branch to track, defaults to the repo's default branch
+	Branch string
+	// ApiURL is the base of the REST API, defaults to https://api.github.com
+	ApiURL string
+	// Hostname is the host repo urls are on, defaults to github.com
+	Hostname string
+	// Transport makes API requests, i.e the controller's so its rate limit tracking covers the config repo too.
+	// Defaults to a GithubTransport of its own
+	Transport http.RoundTripper
 }
 
 func NewGithubGitClient(conf GithubGitClientConfig) (*GithubGitClient, error) {
-	if conf.Token == "" {
+	if conf.Token == "" && conf.TokenSource == nil {
 		return nil, fmt.Errorf("token is required")
 	}
 
-	return &GithubGitClient{
-		log:   conf.Logger,
-		token: conf.Token,
-	}, nil
+	g := &GithubGitClient{
+		log:      conf.Logger,
+		client:   &http.Client{Transport: conf.Transport},
+		tokens:   conf.TokenSource,
+		branch:   conf.Branch,
+		apiURL:   conf.ApiURL,
+		hostname: conf.Hostname,
+	}
+
+	if g.client.Transport == nil {
+		g.client.Transport = NewGithubTransport(GithubTransportConfig{
+			Logger: conf.Logger,
+		})
+	}
+	if g.tokens == nil {
+		g.tokens = StaticGithubToken(conf.Token)
+	}
+	if g.apiURL == "" {
+		g.apiURL = DefaultGithubApiUrl
+	}
+	g.apiURL = apiBaseURL(g.apiURL)
+	if g.hostname == "" {
+		g.hostname = DefaultGithubHostname
+	}
+
+	return g, nil
 }
 
 var _ GitClient = (*GithubGitClient)(nil)
@@ -40,11 +68,20 @@ type GithubGitClient struct {
 	log    zerolog.Logger
 	client *http.Client
 
-	token string
+	tokens   GithubTokenSource
+	branch   string
+	apiURL   string
+	hostname string
 }
 
 func (g *GithubGitClient) parseUrl(url string) (string, string, error) {
-	exp := regexp.MustCompile(`^(https://github.com/|git@github.com:)(?P<owner>[a-zA-Z0-9_\-]+)/(?P<repo>[a-zA-Z0-9_\-]+).git`)
+	hostname := g.hostname
+	if hostname == "" {
+		hostname = DefaultGithubHostname
+	}
+	host := regexp.QuoteMeta(hostname)
+
+	exp := regexp.MustCompile(`^(https://` + host + `/|git@` + host + `:)(?P<owner>[a-zA-Z0-9_\-]+)/(?P<repo>[a-zA-Z0-9_\-]+).git`)
 	got := hlp.ExtractNamedMatches(exp, exp.FindStringSubmatch(url))
 	if got["owner"] == "" {
 		return "", "", fmt.Errorf("unable to extract owner from URL")
@@ -56,11 +93,20 @@ func (g *GithubGitClient) parseUrl(url string) (string, string, error) {
 }
 
 func (g *GithubGitClient) GetLatestCommit(url string) (string, error) {
+	return g.GetBranchCommit(url, g.branch)
+}
+
+func (g *GithubGitClient) GetBranchCommit(url string, branch string) (string, error) {
 	owner, repo, err := g.parseUrl(url)
 	if err != nil {
 		return "", fmt.Errorf("error parsing URL: %w", err)
 	}
 
+	token, err := g.tokens.Token()
+	if err != nil {
+		return "", fmt.Errorf("error getting token: %w", err)
+	}
+
 	type latestCommit struct {
 		SHA string `json:"sha"`
 	}
@@ -68,16 +114,19 @@ func (g *GithubGitClient) GetLatestCommit(url string) (string, error) {
 	var resp []latestCommit
 	var errResp map[string]any
 
-	err = requests.
-		URL("https://api.github.com").
+	builder := requests.
+		URL(g.apiURL).
 		Pathf("repos/%v/%v/commits", owner, repo).
 		Param("per_page", "1").
 		Header("accept", "application/vnd.github+json").
-		Header("Authorization", fmt.Sprintf("Bearer %v", g.token)).
+		Header("Authorization", fmt.Sprintf("Bearer %v", token)).
 		ToJSON(&resp).
 		ErrorJSON(&errResp).
-		Client(g.client).
-		Fetch(context.Background())
+		Client(g.client)
+	if branch != "" {
+		builder = builder.Param("sha", branch)
+	}
+	err = builder.Fetch(withGithubCredential(context.Background(), githubTokenSourceID(g.tokens)))
 
 	if err != nil {
 		g.log.Error().Interface("body", errResp).Msg("error response body")
@@ -88,29 +137,30 @@ func (g *GithubGitClient) GetLatestCommit(url string) (string, error) {
 }
 
 func (g *GithubGitClient) CloneAtCommit(url string, commit string) (fs.FS, error) {
-	bfs := gmemfs.New()
-	r, err := git.Clone(memory.NewStorage(), bfs, &git.CloneOptions{
-		Auth: &ghttp.BasicAuth{
-			Username: "__token__",
-			Password: g.token,
-		},
-		URL:   url,
-		Depth: 1,
-	})
+	token, err := g.tokens.Token()
 	if err != nil {
-		return nil, fmt.Errorf("error cloning: %w", err)
+		return nil, fmt.Errorf("error getting token: %w", err)
 	}
 
-	w, err := r.Worktree()
+	return cloneAtCommit(url, g.branch, commit, g.gitAuth(token))
+}
+
+// ListTags lists tags over the git protocol rather than the API, which doesn't resolve annotated tags
+func (g *GithubGitClient) ListTags(url string) ([]GitTag, error) {
+	token, err := g.tokens.Token()
 	if err != nil {
-		return nil, fmt.Errorf("error getting worktree: %w", err)
+		return nil, fmt.Errorf("error getting token: %w", err)
 	}
 
-	if err := w.Checkout(&git.CheckoutOptions{Hash: plumbing.NewHash(commit)}); err != nil {
-		return nil, fmt.Errorf("error checking out commit: %w", err)
-	}
+	return listRemoteTags(url, g.gitAuth(token))
+}
 
-	return iofs.New(bfs), nil
+func (g *GithubGitClient) gitAuth(token string) *ghttp.BasicAuth {
+	// GitHub ignores the username for token auth, but app installation tokens are documented with this one
+	return &ghttp.BasicAuth{
+		Username: "x-access-token",
+		Password: token,
+	}
 }
 
 func (g *GithubGitClient) GetLatestRelease(url string) (string, error) {
@@ -119,6 +169,11 @@ func (g *GithubGitClient) GetLatestRelease(url string) (string, error) {
 		return "", fmt.Errorf("error parsing URL: %w", err)
 	}
 
+	token, err := g.tokens.Token()
+	if err != nil {
+		return "", fmt.Errorf("error getting token: %w", err)
+	}
+
 	type respType struct {
 		TagName string `json:"tag_name"`
 	}
@@ -127,13 +182,14 @@ func (g *GithubGitClient) GetLatestRelease(url string) (string, error) {
 	var errResp map[string]any
 
 	err = requests.
-		URL("https://api.github.com").
+		URL(g.apiURL).
 		Pathf("repos/%v/%v/releases/latest", owner, repo).
 		Header("accept", "application/vnd.github+json").
-		Header("Authorization", fmt.Sprintf("Bearer %v", g.token)).
+		Header("Authorization", fmt.Sprintf("Bearer %v", token)).
 		ToJSON(&resp).
 		ErrorJSON(&errResp).
-		Fetch(context.Background())
+		Client(g.client).
+		Fetch(withGithubCredential(context.Background(), githubTokenSourceID(g.tokens)))
 	if err != nil {
 		g.log.Error().Interface("body", errResp).Msg("error response body")
 		return "", fmt.Errorf("error getting latest release: %w", err)
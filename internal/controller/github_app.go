@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/golang-jwt/jwt"
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+)
+
+const (
+	// GitHub rejects app JWTs that live longer than 10 minutes
+	githubAppJWTDuration = 9 * time.Minute
+	// Backdates the JWT to allow for clock drift between us and GitHub
+	githubAppJWTSkew = time.Minute
+	// Installation tokens are refreshed this far ahead of expiry, so a token handed to an agent is still good by the
+	// time it downloads with it
+	githubAppTokenRefreshMargin = 10 * time.Minute
+	// Every GitHub request waits on the exchange while it's running, so it can't be left to hang
+	defaultGithubAppTokenTimeout = 30 * time.Second
+)
+
+// GithubTokenSource provides the token used to authenticate to GitHub
+type GithubTokenSource interface {
+	Token() (string, error)
+}
+
+type GithubAppConfig struct {
+	Logger         zerolog.Logger
+	HttpClient     *http.Client
+	AppID          int64
+	InstallationID int64
+	PrivateKey     *rsa.PrivateKey
+	// ApiURL is the base of the REST API, defaults to https://api.github.com
+	ApiURL string
+	// Timeout bounds each installation token exchange, defaults to 30s
+	Timeout time.Duration
+	NowFunc func() time.Time
+}
+
+func NewGithubApp(conf GithubAppConfig) (*GithubApp, error) {
+	if conf.AppID == 0 {
+		return nil, fmt.Errorf("app id is required")
+	}
+	if conf.InstallationID == 0 {
+		return nil, fmt.Errorf("installation id is required")
+	}
+	if conf.PrivateKey == nil {
+		return nil, fmt.Errorf("private key is required")
+	}
+
+	app := &GithubApp{
+		log:            conf.Logger,
+		client:         conf.HttpClient,
+		appID:          conf.AppID,
+		installationID: conf.InstallationID,
+		privateKey:     conf.PrivateKey,
+		apiURL:         conf.ApiURL,
+		timeout:        conf.Timeout,
+		now:            conf.NowFunc,
+		mu:             &sync.Mutex{},
+	}
+
+	if app.timeout <= 0 {
+		app.timeout = defaultGithubAppTokenTimeout
+	}
+	if app.client == nil {
+		app.client = &http.Client{Timeout: app.timeout}
+	}
+
+	if app.apiURL == "" {
+		app.apiURL = DefaultGithubApiUrl
+	}
+	app.apiURL = apiBaseURL(app.apiURL)
+	if app.now == nil {
+		app.now = func() time.Time {
+			return time.Now().UTC()
+		}
+	}
+
+	return app, nil
+}
+
+// NewGithubAppFromEnv returns a token source for the configured GitHub App, or nil if no app is configured
+func NewGithubAppFromEnv(logger zerolog.Logger) (GithubTokenSource, error) {
+	return newGithubAppFromKeys(logger, githubAppKeys{
+		appID:          GithubAppID,
+		installationID: GithubAppInstallationID,
+		privateKeyPath: GithubAppPrivateKeyPath,
+	}, viper.GetString(GithubApiUrl))
+}
+
+// githubAppKeys are the config keys a GitHub App is read from, either the default host's or a named host's
+type githubAppKeys struct {
+	appID          string
+	installationID string
+	privateKeyPath string
+}
+
+func newGithubAppFromKeys(logger zerolog.Logger, keys githubAppKeys, apiURL string) (GithubTokenSource, error) {
+	if viper.GetString(keys.appID) == "" {
+		return nil, nil
+	}
+
+	appID, err := parseGithubAppID(keys.appID)
+	if err != nil {
+		return nil, err
+	}
+	installationID, err := parseGithubAppID(keys.installationID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPath := viper.GetString(keys.privateKeyPath)
+	if keyPath == "" {
+		return nil, fmt.Errorf("%v is required", keys.privateKeyPath)
+	}
+	keyBytes, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading github app private key: %w", err)
+	}
+	// GitHub hands out PKCS1 keys, but be lenient about PKCS8 in case it's been converted
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding github app private key: %w", err)
+	}
+
+	app, err := NewGithubApp(GithubAppConfig{
+		Logger:         logger,
+		AppID:          appID,
+		InstallationID: installationID,
+		PrivateKey:     key,
+		ApiURL:         apiURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing github app: %w", err)
+	}
+	return app, nil
+}
+
+// parseGithubAppID reads a numeric app or installation id, naming the key on failure since viper would otherwise
+// quietly read a typo as 0
+func parseGithubAppID(key string) (int64, error) {
+	value := viper.GetString(key)
+	if value == "" {
+		return 0, fmt.Errorf("%v is required", key)
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%v must be a positive number, got %q", key, value)
+	}
+	return id, nil
+}
+
+var _ GithubTokenSource = (*GithubApp)(nil)
+
+// GithubApp authenticates as an installation of a GitHub App, exchanging a JWT signed by the app key for a short lived
+// installation token
+type GithubApp struct {
+	log            zerolog.Logger
+	client         *http.Client
+	appID          int64
+	installationID int64
+	privateKey     *rsa.PrivateKey
+	apiURL         string
+	timeout        time.Duration
+	now            func() time.Time
+
+	mu      *sync.Mutex
+	token   string
+	expires time.Time
+}
+
+type githubInstallationToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Token returns the current installation token, exchanging for a new one if it's missing or close to expiring. Callers
+// wait on the exchange rather than making their own, which is bounded by the app's timeout
+func (a *GithubApp) Token() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && a.now().Add(githubAppTokenRefreshMargin).Before(a.expires) {
+		return a.token, nil
+	}
+
+	a.log.Debug().Msg("installation token missing or expiring, exchanging for a new one")
+	appJWT, err := a.signJWT()
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+	defer cancel()
+
+	var resp githubInstallationToken
+	var errResp map[string]any
+	err = requests.
+		URL(a.apiURL).
+		Pathf("app/installations/%v/access_tokens", a.installationID).
+		Post().
+		Header("accept", "application/vnd.github+json").
+		Header("Authorization", fmt.Sprintf("Bearer %v", appJWT)).
+		Client(a.client).
+		ToJSON(&resp).
+		ErrorJSON(&errResp).
+		Fetch(ctx)
+	if err != nil {
+		a.log.Error().Interface("body", errResp).Msg("error response body")
+		return "", fmt.Errorf("error exchanging for installation token: %w", err)
+	}
+
+	a.token = resp.Token
+	a.expires = resp.ExpiresAt
+
+	return a.token, nil
+}
+
+func (a *GithubApp) credentialID() string {
+	return fmt.Sprintf("app:%v:%v", a.appID, a.installationID)
+}
+
+func (a *GithubApp) signJWT() (string, error) {
+	now := a.now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.StandardClaims{
+		IssuedAt:  now.Add(-githubAppJWTSkew).Unix(),
+		ExpiresAt: now.Add(githubAppJWTDuration).Unix(),
+		Issuer:    strconv.FormatInt(a.appID, 10),
+	})
+
+	signed, err := token.SignedString(a.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("error signing app jwt: %w", err)
+	}
+	return signed, nil
+}
+
+// StaticGithubToken is a fixed token, i.e a personal access token
+type StaticGithubToken string
+
+func (s StaticGithubToken) Token() (string, error) {
+	return string(s), nil
+}
+
+func (s StaticGithubToken) credentialID() string {
+	sum := sha256.Sum256([]byte(s))
+	return "token:" + hex.EncodeToString(sum[:])
+}
+
+// identifiedTokenSource is a token source that can name its credential independently of the current token
+type identifiedTokenSource interface {
+	credentialID() string
+}
+
+// githubTokenSourceID identifies the credential behind a token source, empty if it can't be told apart from others
+func githubTokenSourceID(source GithubTokenSource) string {
+	identified, ok := source.(identifiedTokenSource)
+	if !ok {
+		return ""
+	}
+	return identified.credentialID()
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGithubApp struct {
+	publicKey *rsa.PublicKey
+	now       func() time.Time
+	exchanges atomic.Int32
+}
+
+func (f *fakeGithubApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/app/installations/99/access_tokens":
+		appJWT := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		claims := &jwt.StandardClaims{}
+		// The fake clock isn't the real one, so only the signature is checked by the parser
+		parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodRS256.Alg()}, SkipClaimsValidation: true}
+		_, err := parser.ParseWithClaims(appJWT, claims, func(t *jwt.Token) (any, error) {
+			return f.publicKey, nil
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if claims.Issuer != "12" || claims.ExpiresAt-claims.IssuedAt > int64((10*time.Minute).Seconds()) {
+			http.Error(w, "bad claims", http.StatusUnauthorized)
+			return
+		}
+
+		n := f.exchanges.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(githubInstallationToken{
+			Token:     fmt.Sprintf("installation-token-%v", n),
+			ExpiresAt: f.now().Add(time.Hour),
+		})
+	case r.Method == http.MethodGet && r.URL.Path == "/repos/some/repo/commits":
+		if r.Header.Get("Authorization") != "Bearer installation-token-1" {
+			http.Error(w, "bad credentials", http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]map[string]any{{"sha": "abc123"}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestGithubApp(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc := func() time.Time {
+		return now
+	}
+
+	fake := &fakeGithubApp{publicKey: &key.PublicKey, now: nowFunc}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	app, err := NewGithubApp(GithubAppConfig{
+		HttpClient:     server.Client(),
+		AppID:          12,
+		InstallationID: 99,
+		PrivateKey:     key,
+		ApiURL:         server.URL,
+		NowFunc:        nowFunc,
+	})
+	require.NoError(t, err)
+
+	t.Run("exchanges and caches", func(t *testing.T) {
+		token, err := app.Token()
+		require.NoError(t, err)
+		require.Equal(t, "installation-token-1", token)
+
+		now = now.Add(30 * time.Minute)
+		token, err = app.Token()
+		require.NoError(t, err)
+		require.Equal(t, "installation-token-1", token)
+		require.Equal(t, int32(1), fake.exchanges.Load())
+	})
+
+	t.Run("git client", func(t *testing.T) {
+		client, err := NewGithubGitClient(GithubGitClientConfig{
+			TokenSource: app,
+			ApiURL:      server.URL,
+		})
+		require.NoError(t, err)
+
+		commit, err := client.GetLatestCommit("https://github.com/some/repo.git")
+		require.NoError(t, err)
+		require.Equal(t, "abc123", commit)
+	})
+
+	t.Run("refreshes before expiry", func(t *testing.T) {
+		now = now.Add(25 * time.Minute)
+		token, err := app.Token()
+		require.NoError(t, err)
+		require.Equal(t, "installation-token-2", token)
+		require.Equal(t, int32(2), fake.exchanges.Load())
+	})
+}
+
+func TestGithubApp_ExchangeError(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	fake := &fakeGithubApp{publicKey: &other.PublicKey, now: time.Now}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	app, err := NewGithubApp(GithubAppConfig{
+		HttpClient:     server.Client(),
+		AppID:          12,
+		InstallationID: 99,
+		PrivateKey:     key,
+		ApiURL:         server.URL,
+	})
+	require.NoError(t, err)
+
+	_, err = app.Token()
+	require.ErrorContains(t, err, "error exchanging for installation token")
+}
+
+func TestGithubApp_ExchangeTimeout(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// A token endpoint that never answers
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	app, err := NewGithubApp(GithubAppConfig{
+		AppID:          12,
+		InstallationID: 99,
+		PrivateKey:     key,
+		ApiURL:         server.URL,
+		Timeout:        50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = app.Token()
+	require.ErrorContains(t, err, "error exchanging for installation token")
+	require.Less(t, time.Since(start), 5*time.Second)
+}
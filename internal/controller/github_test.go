@@ -10,10 +10,11 @@ func TestGithub_ParseUrl(t *testing.T) {
 	t.Parallel()
 
 	testData := []struct {
-		name  string
-		url   string
-		owner string
-		repo  string
+		name     string
+		hostname string
+		url      string
+		owner    string
+		repo     string
 	}{
 		{
 			name:  "ssh",
@@ -27,15 +28,36 @@ func TestGithub_ParseUrl(t *testing.T) {
 			owner: "nicjohnson145",
 			repo:  "plantr",
 		},
+		{
+			name:     "enterprise ssh",
+			hostname: "ghe.example.com",
+			url:      "git@ghe.example.com:some-org/some-repo.git",
+			owner:    "some-org",
+			repo:     "some-repo",
+		},
+		{
+			name:     "enterprise https",
+			hostname: "ghe.example.com",
+			url:      "https://ghe.example.com/some-org/some-repo.git",
+			owner:    "some-org",
+			repo:     "some-repo",
+		},
 	}
 	for _, tc := range testData {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			owner, repo, err := (&GithubGitClient{}).parseUrl(tc.url)
+			owner, repo, err := (&GithubGitClient{hostname: tc.hostname}).parseUrl(tc.url)
 			require.NoError(t, err)
 			require.Equal(t, tc.owner, owner)
 			require.Equal(t, tc.repo, repo)
 		})
 	}
 }
+
+func TestGithub_ParseUrlWrongHost(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := (&GithubGitClient{hostname: "ghe.example.com"}).parseUrl("https://github.com/nicjohnson145/plantr.git")
+	require.Error(t, err)
+}
@@ -0,0 +1,366 @@
+package controller
+
+import (
+	"bytes"
+	"cmp"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	ErrGithubRateLimitedError = errors.New("github rate limit exhausted")
+)
+
+const (
+	// defaultGithubResponseCacheSize bounds the responses kept for revalidation, least recently used are evicted first
+	defaultGithubResponseCacheSize = 512
+)
+
+// GithubRateLimit is the last quota GitHub reported for an API host
+type GithubRateLimit struct {
+	Host      string
+	Resource  string
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	// Updated is when the quota was last reported
+	Updated time.Time
+}
+
+type GithubTransportConfig struct {
+	Logger zerolog.Logger
+	// Base makes the actual requests, defaults to http.DefaultTransport
+	Base http.RoundTripper
+	// MaxWait is the longest a request will wait for an exhausted quota to reset, beyond that it fails immediately
+	MaxWait time.Duration
+	// CacheSize is the most responses kept for revalidation, defaults to 512
+	CacheSize int
+	NowFunc   func() time.Time
+}
+
+func NewGithubTransport(conf GithubTransportConfig) *GithubTransport {
+	t := &GithubTransport{
+		log:       conf.Logger,
+		base:      conf.Base,
+		maxWait:   conf.MaxWait,
+		now:       conf.NowFunc,
+		mu:        &sync.Mutex{},
+		limits:    map[string]GithubRateLimit{},
+		responses: newGithubResponseCache(conf.CacheSize),
+	}
+
+	if t.base == nil {
+		t.base = http.DefaultTransport
+	}
+	if t.now == nil {
+		t.now = func() time.Time {
+			return time.Now().UTC()
+		}
+	}
+	t.sleep = func(ctx context.Context, d time.Duration) error {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		}
+	}
+
+	return t
+}
+
+var _ http.RoundTripper = (*GithubTransport)(nil)
+
+// GithubTransport tracks the rate limit GitHub reports on each response, refusing to send requests it knows will be
+// rejected, and revalidates repeated GETs with their ETag so unchanged responses don't count against the quota
+type GithubTransport struct {
+	log     zerolog.Logger
+	base    http.RoundTripper
+	maxWait time.Duration
+	now     func() time.Time
+	// for unit tests
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu        *sync.Mutex
+	limits    map[string]GithubRateLimit
+	responses *githubResponseCache
+}
+
+type cachedGithubResponse struct {
+	key    string
+	etag   string
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (t *GithubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.waitForQuota(req); err != nil {
+		return nil, err
+	}
+
+	key := ""
+	cached, hasCached := cachedGithubResponse{}, false
+	if req.Method == http.MethodGet {
+		key = responseCacheKey(req)
+		t.mu.Lock()
+		cached, hasCached = t.responses.get(key)
+		t.mu.Unlock()
+		if hasCached {
+			req = req.Clone(req.Context())
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, hasLimit := t.recordRateLimit(req, resp)
+
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		t.log.Trace().Str("url", req.URL.String()).Msg("not modified, serving cached response")
+		drainAndClose(resp.Body)
+		return cached.response(req), nil
+	}
+
+	if isRateLimitedResponse(resp) {
+		drainAndClose(resp.Body)
+		reset := t.now()
+		if hasLimit {
+			reset = limit.Reset
+		}
+		return nil, fmt.Errorf("%w for %v, resets at %v", ErrGithubRateLimitedError, req.URL.Host, reset.Format(time.RFC3339))
+	}
+
+	etag := resp.Header.Get("ETag")
+	if key == "" || etag == "" || resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.mu.Lock()
+	t.responses.put(cachedGithubResponse{
+		key:    key,
+		etag:   etag,
+		status: resp.StatusCode,
+		header: resp.Header.Clone(),
+		body:   body,
+	})
+	t.mu.Unlock()
+
+	return resp, nil
+}
+
+// RateLimits returns the last quota reported for each resource of each host, sorted by host then resource
+func (t *GithubTransport) RateLimits() []GithubRateLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make([]GithubRateLimit, 0, len(t.limits))
+	for _, limit := range t.limits {
+		out = append(out, limit)
+	}
+	slices.SortFunc(out, func(a GithubRateLimit, b GithubRateLimit) int {
+		return cmp.Or(strings.Compare(a.Host, b.Host), strings.Compare(a.Resource, b.Resource))
+	})
+
+	return out
+}
+
+// waitForQuota fails fast if the quota the request counts against is known to be exhausted, unless it resets within
+// the max wait
+func (t *GithubTransport) waitForQuota(req *http.Request) error {
+	t.mu.Lock()
+	limit, ok := t.limits[rateLimitKey(req.URL.Host, requestResource(req))]
+	t.mu.Unlock()
+
+	if !ok || limit.Remaining > 0 {
+		return nil
+	}
+	wait := limit.Reset.Sub(t.now())
+	if wait <= 0 {
+		return nil
+	}
+	if wait > t.maxWait {
+		return fmt.Errorf("%w for %v, resets at %v", ErrGithubRateLimitedError, req.URL.Host, limit.Reset.Format(time.RFC3339))
+	}
+
+	t.log.Warn().Str("host", req.URL.Host).Msgf("rate limit exhausted, waiting %v for it to reset", wait)
+	return t.sleep(req.Context(), wait)
+}
+
+// recordRateLimit stores the quota from the response headers, under the resource GitHub says the request counted
+// against. Secondary rate limits reject the request with only a Retry-After, which is treated as exhausting the
+// resource until then
+func (t *GithubTransport) recordRateLimit(req *http.Request, resp *http.Response) (GithubRateLimit, bool) {
+	limit := GithubRateLimit{
+		Host:     req.URL.Host,
+		Resource: resp.Header.Get("X-RateLimit-Resource"),
+		Updated:  t.now(),
+	}
+	if limit.Resource == "" {
+		limit.Resource = requestResource(req)
+	}
+
+	remaining, remainingErr := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	reset, resetErr := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	retryAfter, retryErr := strconv.Atoi(resp.Header.Get("Retry-After"))
+
+	switch {
+	case retryErr == nil && isRejectedStatus(resp.StatusCode):
+		limit.Remaining = 0
+		limit.Reset = t.now().Add(time.Duration(retryAfter) * time.Second)
+	case remainingErr == nil && resetErr == nil:
+		limit.Remaining = remaining
+		limit.Reset = time.Unix(reset, 0).UTC()
+	default:
+		return GithubRateLimit{}, false
+	}
+	limit.Limit, _ = strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+
+	t.mu.Lock()
+	t.limits[rateLimitKey(limit.Host, limit.Resource)] = limit
+	t.mu.Unlock()
+
+	return limit, true
+}
+
+func isRejectedStatus(status int) bool {
+	return status == http.StatusForbidden || status == http.StatusTooManyRequests
+}
+
+func isRateLimitedResponse(resp *http.Response) bool {
+	if !isRejectedStatus(resp.StatusCode) {
+		return false
+	}
+	return resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != ""
+}
+
+func rateLimitKey(host string, resource string) string {
+	return host + "|" + resource
+}
+
+// requestResource guesses the quota a request counts against before GitHub says, so an exhausted search quota doesn't
+// hold up core requests and vice versa
+func requestResource(req *http.Request) string {
+	path := strings.TrimSuffix(req.URL.Path, "/")
+	switch {
+	case strings.HasSuffix(path, "/graphql"):
+		return "graphql"
+	case strings.Contains(path+"/", "/search/code/"):
+		return "code_search"
+	case strings.Contains(path+"/", "/search/"):
+		return "search"
+	default:
+		return "core"
+	}
+}
+
+type githubCredentialCtxKey struct{}
+
+// withGithubCredential names the credential requests made with ctx authenticate with, so cached responses are shared
+// across rotations of that credential's token rather than stranded by them
+func withGithubCredential(ctx context.Context, credential string) context.Context {
+	if credential == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, githubCredentialCtxKey{}, credential)
+}
+
+// responseCacheKey separates cached responses by credential. Requests that don't name theirs fall back to a hash of
+// their auth headers, so the credential itself isn't held onto
+func responseCacheKey(req *http.Request) string {
+	if credential, ok := req.Context().Value(githubCredentialCtxKey{}).(string); ok {
+		return req.URL.String() + "|" + credential
+	}
+	auth := sha256.Sum256([]byte(req.Header.Get("Authorization") + "|" + req.Header.Get("PRIVATE-TOKEN")))
+	return req.URL.String() + "|" + hex.EncodeToString(auth[:])
+}
+
+// githubResponseCache is a least recently used cache of responses, not safe for concurrent use
+type githubResponseCache struct {
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newGithubResponseCache(size int) *githubResponseCache {
+	if size <= 0 {
+		size = defaultGithubResponseCacheSize
+	}
+	return &githubResponseCache{
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (c *githubResponseCache) get(key string) (cachedGithubResponse, bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return cachedGithubResponse{}, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(cachedGithubResponse), true
+}
+
+func (c *githubResponseCache) put(resp cachedGithubResponse) {
+	if elem, ok := c.entries[resp.key]; ok {
+		elem.Value = resp
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[resp.key] = c.order.PushFront(resp)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(cachedGithubResponse).key)
+	}
+}
+
+func (c *githubResponseCache) len() int {
+	return c.order.Len()
+}
+
+func (c cachedGithubResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%v %v", c.status, http.StatusText(c.status)),
+		StatusCode:    c.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, body)
+	body.Close()
+}
@@ -0,0 +1,398 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/carlmjohnson/requests"
+	"github.com/jarcoal/httpmock"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func rateLimitHeaders(remaining int, reset time.Time) http.Header {
+	return http.Header{
+		"X-Ratelimit-Limit":     []string{"5000"},
+		"X-Ratelimit-Remaining": []string{strconv.Itoa(remaining)},
+		"X-Ratelimit-Reset":     []string{strconv.FormatInt(reset.Unix(), 10)},
+		"X-Ratelimit-Resource":  []string{"core"},
+	}
+}
+
+func TestGithubTransport(t *testing.T) {
+	t.Parallel()
+
+	const url = "https://api.github.com/repos/some/repo/releases"
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(time.Hour)
+
+	newTransport := func(t *testing.T, maxWait time.Duration) (*httpmock.MockTransport, *GithubTransport, *http.Client) {
+		t.Helper()
+
+		mockTransport := httpmock.NewMockTransport()
+		transport := NewGithubTransport(GithubTransportConfig{
+			Base:    mockTransport,
+			MaxWait: maxWait,
+			NowFunc: func() time.Time {
+				return now
+			},
+		})
+		return mockTransport, transport, &http.Client{Transport: transport}
+	}
+
+	fetch := func(client *http.Client) (string, error) {
+		var body string
+		err := requests.URL(url).Client(client).ToString(&body).Fetch(context.Background())
+		return body, err
+	}
+
+	t.Run("conditional requests", func(t *testing.T) {
+		t.Parallel()
+
+		mockTransport, transport, client := newTransport(t, 0)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			func(req *http.Request) (*http.Response, error) {
+				require.Empty(t, req.Header.Get("If-None-Match"))
+				resp := httpmock.NewStringResponse(http.StatusOK, "some-body")
+				resp.Header = rateLimitHeaders(4999, reset)
+				resp.Header.Set("ETag", `"some-etag"`)
+				return resp, nil
+			},
+		)
+
+		body, err := fetch(client)
+		require.NoError(t, err)
+		require.Equal(t, "some-body", body)
+
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			func(req *http.Request) (*http.Response, error) {
+				require.Equal(t, `"some-etag"`, req.Header.Get("If-None-Match"))
+				resp := httpmock.NewStringResponse(http.StatusNotModified, "")
+				// Not modified responses don't count against the quota
+				resp.Header = rateLimitHeaders(4999, reset)
+				return resp, nil
+			},
+		)
+
+		body, err = fetch(client)
+		require.NoError(t, err)
+		require.Equal(t, "some-body", body)
+
+		require.Equal(
+			t,
+			[]GithubRateLimit{
+				{
+					Host:      "api.github.com",
+					Resource:  "core",
+					Limit:     5000,
+					Remaining: 4999,
+					Reset:     reset,
+					Updated:   now,
+				},
+			},
+			transport.RateLimits(),
+		)
+	})
+
+	t.Run("survives token rotation", func(t *testing.T) {
+		t.Parallel()
+
+		mockTransport, transport, client := newTransport(t, 0)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			func(req *http.Request) (*http.Response, error) {
+				if req.Header.Get("If-None-Match") == `"some-etag"` {
+					return httpmock.NewStringResponse(http.StatusNotModified, ""), nil
+				}
+				resp := httpmock.NewStringResponse(http.StatusOK, "some-body")
+				resp.Header.Set("ETag", `"some-etag"`)
+				return resp, nil
+			},
+		)
+
+		ctx := withGithubCredential(context.Background(), "app:12:99")
+		for _, token := range []string{"installation-token-1", "installation-token-2"} {
+			var body string
+			err := requests.URL(url).Client(client).Bearer(token).ToString(&body).Fetch(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "some-body", body)
+		}
+		require.Equal(t, 1, transport.responses.len())
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		t.Parallel()
+
+		mockTransport := httpmock.NewMockTransport()
+		mockTransport.RegisterRegexpResponder(
+			http.MethodGet,
+			regexp.MustCompile(`^https://api\.github\.com/repos/`),
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusOK, "some-body")
+				resp.Header.Set("ETag", `"`+req.URL.Path+`"`)
+				return resp, nil
+			},
+		)
+		transport := NewGithubTransport(GithubTransportConfig{Base: mockTransport, CacheSize: 2})
+		client := &http.Client{Transport: transport}
+
+		for _, repo := range []string{"first", "second", "first", "third"} {
+			err := requests.URL("https://api.github.com/repos/some/" + repo).Client(client).Fetch(context.Background())
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 2, transport.responses.len())
+		_, ok := transport.responses.get(responseCacheKey(httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/some/second", nil)))
+		require.False(t, ok)
+		_, ok = transport.responses.get(responseCacheKey(httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/some/first", nil)))
+		require.True(t, ok)
+	})
+
+	t.Run("fails fast when exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		mockTransport, _, client := newTransport(t, time.Minute)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusForbidden, `{"message": "API rate limit exceeded"}`)
+				resp.Header = rateLimitHeaders(0, reset)
+				return resp, nil
+			},
+		)
+
+		_, err := fetch(client)
+		require.ErrorIs(t, err, ErrGithubRateLimitedError)
+
+		// Known to be exhausted, so it never leaves the controller
+		_, err = fetch(client)
+		require.ErrorIs(t, err, ErrGithubRateLimitedError)
+		require.Equal(t, 1, mockTransport.GetCallCountInfo()["GET "+url])
+	})
+
+	t.Run("tracks resources separately", func(t *testing.T) {
+		t.Parallel()
+
+		const searchURL = "https://api.github.com/search/repositories"
+
+		mockTransport, transport, client := newTransport(t, 0)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			searchURL,
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusForbidden, "")
+				resp.Header = rateLimitHeaders(0, reset)
+				resp.Header.Set("X-RateLimit-Resource", "search")
+				return resp, nil
+			},
+		)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusOK, "some-body")
+				resp.Header = rateLimitHeaders(4000, reset)
+				return resp, nil
+			},
+		)
+
+		err := requests.URL(searchURL).Client(client).Fetch(context.Background())
+		require.ErrorIs(t, err, ErrGithubRateLimitedError)
+
+		// An exhausted search quota leaves core requests alone
+		body, err := fetch(client)
+		require.NoError(t, err)
+		require.Equal(t, "some-body", body)
+
+		limits := transport.RateLimits()
+		require.Len(t, limits, 2)
+		require.Equal(t, "core", limits[0].Resource)
+		require.Equal(t, 4000, limits[0].Remaining)
+		require.Equal(t, "search", limits[1].Resource)
+		require.Equal(t, 0, limits[1].Remaining)
+	})
+
+	t.Run("only rejections exhaust with retry-after", func(t *testing.T) {
+		t.Parallel()
+
+		mockTransport, transport, client := newTransport(t, 0)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusServiceUnavailable, "")
+				resp.Header.Set("Retry-After", "3600")
+				return resp, nil
+			},
+		)
+
+		_, err := fetch(client)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrGithubRateLimitedError)
+
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			httpmock.NewStringResponder(http.StatusOK, "some-body"),
+		)
+
+		body, err := fetch(client)
+		require.NoError(t, err)
+		require.Equal(t, "some-body", body)
+		require.Empty(t, transport.RateLimits())
+	})
+
+	t.Run("waits for a reset within max wait", func(t *testing.T) {
+		t.Parallel()
+
+		mockTransport, transport, client := newTransport(t, 5*time.Minute)
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusTooManyRequests, "")
+				resp.Header.Set("Retry-After", "60")
+				return resp, nil
+			},
+		)
+
+		var waited time.Duration
+		transport.sleep = func(ctx context.Context, d time.Duration) error {
+			waited = d
+			return nil
+		}
+
+		_, err := fetch(client)
+		require.ErrorIs(t, err, ErrGithubRateLimitedError)
+
+		mockTransport.RegisterResponder(
+			http.MethodGet,
+			url,
+			httpmock.NewStringResponder(http.StatusOK, "some-body"),
+		)
+
+		body, err := fetch(client)
+		require.NoError(t, err)
+		require.Equal(t, "some-body", body)
+		require.Equal(t, time.Minute, waited)
+	})
+}
+
+func TestGetGithubRateLimits(t *testing.T) {
+	t.Parallel()
+
+	const url = "https://api.github.com/repos/some/repo/releases"
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(30 * time.Minute)
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		url,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusForbidden, "")
+			resp.Header = rateLimitHeaders(0, reset)
+			return resp, nil
+		},
+	)
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			HttpClient: &http.Client{Transport: mockTransport},
+			NowFunc: func() time.Time {
+				return now
+			},
+		},
+		nil,
+	)
+
+	_, err := ctrl.listReleases(context.Background(), ctrl.releaseHosts[""], "some/repo")
+	require.ErrorIs(t, err, ErrGithubRateLimitedError)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(ctrl.logAndHandleError(err, "")))
+
+	resp, err := ctrl.GetGithubRateLimits(context.Background(), connect.NewRequest(&pbv1.GetGithubRateLimitsRequest{}))
+	require.NoError(t, err)
+	pbEqual(
+		t,
+		[]*pbv1.GithubRateLimit{
+			{
+				Host:      "api.github.com",
+				Resource:  "core",
+				Limit:     5000,
+				Remaining: 0,
+				ResetsAt:  timestamppb.New(reset),
+				UpdatedAt: timestamppb.New(now),
+			},
+		},
+		resp.Msg.RateLimits,
+	)
+}
+
+func TestGetGithubRateLimits_SharedWithGitClient(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(30 * time.Minute)
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		"https://api.github.com/repos/some/config/commits",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusOK, `[{"sha": "abc123"}]`)
+			resp.Header = rateLimitHeaders(4999, reset)
+			return resp, nil
+		},
+	)
+
+	transport := NewGithubTransport(GithubTransportConfig{
+		Base: mockTransport,
+		NowFunc: func() time.Time {
+			return now
+		},
+	})
+	gitClient, err := NewGithubGitClient(GithubGitClientConfig{
+		Token:     "some-token",
+		Transport: transport,
+	})
+	require.NoError(t, err)
+
+	ctrl := newControllerWithConfig(t, ControllerConfig{GithubTransport: transport}, nil)
+
+	commit, err := gitClient.GetLatestCommit("https://github.com/some/config.git")
+	require.NoError(t, err)
+	require.Equal(t, "abc123", commit)
+
+	resp, err := ctrl.GetGithubRateLimits(context.Background(), connect.NewRequest(&pbv1.GetGithubRateLimitsRequest{}))
+	require.NoError(t, err)
+	pbEqual(
+		t,
+		[]*pbv1.GithubRateLimit{
+			{
+				Host:      "api.github.com",
+				Resource:  "core",
+				Limit:     5000,
+				Remaining: 4999,
+				ResetsAt:  timestamppb.New(reset),
+				UpdatedAt: timestamppb.New(now),
+			},
+		},
+		resp.Msg.RateLimits,
+	)
+}
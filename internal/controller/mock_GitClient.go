@@ -80,6 +80,63 @@ func (_c *MockGitClient_CloneAtCommit_Call) RunAndReturn(run func(string, string
 	return _c
 }
 
+// GetBranchCommit provides a mock function with given fields: url, branch
+func (_m *MockGitClient) GetBranchCommit(url string, branch string) (string, error) {
+	ret := _m.Called(url, branch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBranchCommit")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(url, branch)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(url, branch)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(url, branch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitClient_GetBranchCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBranchCommit'
+type MockGitClient_GetBranchCommit_Call struct {
+	*mock.Call
+}
+
+// GetBranchCommit is a helper method to define mock.On call
+//   - url string
+//   - branch string
+func (_e *MockGitClient_Expecter) GetBranchCommit(url interface{}, branch interface{}) *MockGitClient_GetBranchCommit_Call {
+	return &MockGitClient_GetBranchCommit_Call{Call: _e.mock.On("GetBranchCommit", url, branch)}
+}
+
+func (_c *MockGitClient_GetBranchCommit_Call) Run(run func(url string, branch string)) *MockGitClient_GetBranchCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitClient_GetBranchCommit_Call) Return(_a0 string, _a1 error) *MockGitClient_GetBranchCommit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitClient_GetBranchCommit_Call) RunAndReturn(run func(string, string) (string, error)) *MockGitClient_GetBranchCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestCommit provides a mock function with given fields: url
 func (_m *MockGitClient) GetLatestCommit(url string) (string, error) {
 	ret := _m.Called(url)
@@ -192,6 +249,64 @@ func (_c *MockGitClient_GetLatestRelease_Call) RunAndReturn(run func(string) (st
 	return _c
 }
 
+// ListTags provides a mock function with given fields: url
+func (_m *MockGitClient) ListTags(url string) ([]GitTag, error) {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []GitTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]GitTag, error)); ok {
+		return rf(url)
+	}
+	if rf, ok := ret.Get(0).(func(string) []GitTag); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GitTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitClient_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type MockGitClient_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - url string
+func (_e *MockGitClient_Expecter) ListTags(url interface{}) *MockGitClient_ListTags_Call {
+	return &MockGitClient_ListTags_Call{Call: _e.mock.On("ListTags", url)}
+}
+
+func (_c *MockGitClient_ListTags_Call) Run(run func(url string)) *MockGitClient_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitClient_ListTags_Call) Return(_a0 []GitTag, _a1 error) *MockGitClient_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitClient_ListTags_Call) RunAndReturn(run func(string) ([]GitTag, error)) *MockGitClient_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockGitClient creates a new instance of MockGitClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGitClient(t interface {
@@ -21,6 +21,100 @@ func (_m *MockStorageClient) EXPECT() *MockStorageClient_Expecter {
 	return &MockStorageClient_Expecter{mock: &_m.Mock}
 }
 
+// DeleteChannelPin provides a mock function with given fields: ctx, channel
+func (_m *MockStorageClient) DeleteChannelPin(ctx context.Context, channel string) error {
+	ret := _m.Called(ctx, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChannelPin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, channel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageClient_DeleteChannelPin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChannelPin'
+type MockStorageClient_DeleteChannelPin_Call struct {
+	*mock.Call
+}
+
+// DeleteChannelPin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+func (_e *MockStorageClient_Expecter) DeleteChannelPin(ctx interface{}, channel interface{}) *MockStorageClient_DeleteChannelPin_Call {
+	return &MockStorageClient_DeleteChannelPin_Call{Call: _e.mock.On("DeleteChannelPin", ctx, channel)}
+}
+
+func (_c *MockStorageClient_DeleteChannelPin_Call) Run(run func(ctx context.Context, channel string)) *MockStorageClient_DeleteChannelPin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_DeleteChannelPin_Call) Return(_a0 error) *MockStorageClient_DeleteChannelPin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageClient_DeleteChannelPin_Call) RunAndReturn(run func(context.Context, string) error) *MockStorageClient_DeleteChannelPin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PruneConfigRevisions provides a mock function with given fields: ctx, keep
+func (_m *MockStorageClient) PruneConfigRevisions(ctx context.Context, keep int) error {
+	ret := _m.Called(ctx, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneConfigRevisions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, keep)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageClient_PruneConfigRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneConfigRevisions'
+type MockStorageClient_PruneConfigRevisions_Call struct {
+	*mock.Call
+}
+
+// PruneConfigRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keep int
+func (_e *MockStorageClient_Expecter) PruneConfigRevisions(ctx interface{}, keep interface{}) *MockStorageClient_PruneConfigRevisions_Call {
+	return &MockStorageClient_PruneConfigRevisions_Call{Call: _e.mock.On("PruneConfigRevisions", ctx, keep)}
+}
+
+func (_c *MockStorageClient_PruneConfigRevisions_Call) Run(run func(ctx context.Context, keep int)) *MockStorageClient_PruneConfigRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_PruneConfigRevisions_Call) Return(_a0 error) *MockStorageClient_PruneConfigRevisions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageClient_PruneConfigRevisions_Call) RunAndReturn(run func(context.Context, int) error) *MockStorageClient_PruneConfigRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Purge provides a mock function with given fields: ctx
 func (_m *MockStorageClient) Purge(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -126,23 +220,143 @@ func (_c *MockStorageClient_ReadChallenge_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ReadChannelPin provides a mock function with given fields: ctx, channel
+func (_m *MockStorageClient) ReadChannelPin(ctx context.Context, channel string) (*DBChannelPin, error) {
+	ret := _m.Called(ctx, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadChannelPin")
+	}
+
+	var r0 *DBChannelPin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*DBChannelPin, error)); ok {
+		return rf(ctx, channel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *DBChannelPin); ok {
+		r0 = rf(ctx, channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DBChannelPin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, channel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageClient_ReadChannelPin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadChannelPin'
+type MockStorageClient_ReadChannelPin_Call struct {
+	*mock.Call
+}
+
+// ReadChannelPin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+func (_e *MockStorageClient_Expecter) ReadChannelPin(ctx interface{}, channel interface{}) *MockStorageClient_ReadChannelPin_Call {
+	return &MockStorageClient_ReadChannelPin_Call{Call: _e.mock.On("ReadChannelPin", ctx, channel)}
+}
+
+func (_c *MockStorageClient_ReadChannelPin_Call) Run(run func(ctx context.Context, channel string)) *MockStorageClient_ReadChannelPin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_ReadChannelPin_Call) Return(_a0 *DBChannelPin, _a1 error) *MockStorageClient_ReadChannelPin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageClient_ReadChannelPin_Call) RunAndReturn(run func(context.Context, string) (*DBChannelPin, error)) *MockStorageClient_ReadChannelPin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadConfigRevisions provides a mock function with given fields: ctx, limit
+func (_m *MockStorageClient) ReadConfigRevisions(ctx context.Context, limit int) ([]DBConfigRevision, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadConfigRevisions")
+	}
+
+	var r0 []DBConfigRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]DBConfigRevision, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []DBConfigRevision); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DBConfigRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageClient_ReadConfigRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadConfigRevisions'
+type MockStorageClient_ReadConfigRevisions_Call struct {
+	*mock.Call
+}
+
+// ReadConfigRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockStorageClient_Expecter) ReadConfigRevisions(ctx interface{}, limit interface{}) *MockStorageClient_ReadConfigRevisions_Call {
+	return &MockStorageClient_ReadConfigRevisions_Call{Call: _e.mock.On("ReadConfigRevisions", ctx, limit)}
+}
+
+func (_c *MockStorageClient_ReadConfigRevisions_Call) Run(run func(ctx context.Context, limit int)) *MockStorageClient_ReadConfigRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_ReadConfigRevisions_Call) Return(_a0 []DBConfigRevision, _a1 error) *MockStorageClient_ReadConfigRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageClient_ReadConfigRevisions_Call) RunAndReturn(run func(context.Context, int) ([]DBConfigRevision, error)) *MockStorageClient_ReadConfigRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadGithubReleaseAsset provides a mock function with given fields: ctx, asset
-func (_m *MockStorageClient) ReadGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) (string, error) {
+func (_m *MockStorageClient) ReadGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) (*DBGithubRelease, error) {
 	ret := _m.Called(ctx, asset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadGithubReleaseAsset")
 	}
 
-	var r0 string
+	var r0 *DBGithubRelease
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *DBGithubRelease) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *DBGithubRelease) (*DBGithubRelease, error)); ok {
 		return rf(ctx, asset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *DBGithubRelease) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *DBGithubRelease) *DBGithubRelease); ok {
 		r0 = rf(ctx, asset)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DBGithubRelease)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, *DBGithubRelease) error); ok {
@@ -173,12 +387,129 @@ func (_c *MockStorageClient_ReadGithubReleaseAsset_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockStorageClient_ReadGithubReleaseAsset_Call) Return(_a0 string, _a1 error) *MockStorageClient_ReadGithubReleaseAsset_Call {
+func (_c *MockStorageClient_ReadGithubReleaseAsset_Call) Return(_a0 *DBGithubRelease, _a1 error) *MockStorageClient_ReadGithubReleaseAsset_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStorageClient_ReadGithubReleaseAsset_Call) RunAndReturn(run func(context.Context, *DBGithubRelease) (string, error)) *MockStorageClient_ReadGithubReleaseAsset_Call {
+func (_c *MockStorageClient_ReadGithubReleaseAsset_Call) RunAndReturn(run func(context.Context, *DBGithubRelease) (*DBGithubRelease, error)) *MockStorageClient_ReadGithubReleaseAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadNodeStatus provides a mock function with given fields: ctx, nodeID
+func (_m *MockStorageClient) ReadNodeStatus(ctx context.Context, nodeID string) (*NodeStatus, error) {
+	ret := _m.Called(ctx, nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadNodeStatus")
+	}
+
+	var r0 *NodeStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*NodeStatus, error)); ok {
+		return rf(ctx, nodeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *NodeStatus); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*NodeStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageClient_ReadNodeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadNodeStatus'
+type MockStorageClient_ReadNodeStatus_Call struct {
+	*mock.Call
+}
+
+// ReadNodeStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID string
+func (_e *MockStorageClient_Expecter) ReadNodeStatus(ctx interface{}, nodeID interface{}) *MockStorageClient_ReadNodeStatus_Call {
+	return &MockStorageClient_ReadNodeStatus_Call{Call: _e.mock.On("ReadNodeStatus", ctx, nodeID)}
+}
+
+func (_c *MockStorageClient_ReadNodeStatus_Call) Run(run func(ctx context.Context, nodeID string)) *MockStorageClient_ReadNodeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_ReadNodeStatus_Call) Return(_a0 *NodeStatus, _a1 error) *MockStorageClient_ReadNodeStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageClient_ReadNodeStatus_Call) RunAndReturn(run func(context.Context, string) (*NodeStatus, error)) *MockStorageClient_ReadNodeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadNodeStatuses provides a mock function with given fields: ctx
+func (_m *MockStorageClient) ReadNodeStatuses(ctx context.Context) ([]NodeStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadNodeStatuses")
+	}
+
+	var r0 []NodeStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]NodeStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []NodeStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NodeStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageClient_ReadNodeStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadNodeStatuses'
+type MockStorageClient_ReadNodeStatuses_Call struct {
+	*mock.Call
+}
+
+// ReadNodeStatuses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStorageClient_Expecter) ReadNodeStatuses(ctx interface{}) *MockStorageClient_ReadNodeStatuses_Call {
+	return &MockStorageClient_ReadNodeStatuses_Call{Call: _e.mock.On("ReadNodeStatuses", ctx)}
+}
+
+func (_c *MockStorageClient_ReadNodeStatuses_Call) Run(run func(ctx context.Context)) *MockStorageClient_ReadNodeStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_ReadNodeStatuses_Call) Return(_a0 []NodeStatus, _a1 error) *MockStorageClient_ReadNodeStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageClient_ReadNodeStatuses_Call) RunAndReturn(run func(context.Context) ([]NodeStatus, error)) *MockStorageClient_ReadNodeStatuses_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -230,6 +561,100 @@ func (_c *MockStorageClient_WriteChallenge_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// WriteChannelPin provides a mock function with given fields: ctx, pin
+func (_m *MockStorageClient) WriteChannelPin(ctx context.Context, pin *DBChannelPin) error {
+	ret := _m.Called(ctx, pin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteChannelPin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *DBChannelPin) error); ok {
+		r0 = rf(ctx, pin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageClient_WriteChannelPin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteChannelPin'
+type MockStorageClient_WriteChannelPin_Call struct {
+	*mock.Call
+}
+
+// WriteChannelPin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pin *DBChannelPin
+func (_e *MockStorageClient_Expecter) WriteChannelPin(ctx interface{}, pin interface{}) *MockStorageClient_WriteChannelPin_Call {
+	return &MockStorageClient_WriteChannelPin_Call{Call: _e.mock.On("WriteChannelPin", ctx, pin)}
+}
+
+func (_c *MockStorageClient_WriteChannelPin_Call) Run(run func(ctx context.Context, pin *DBChannelPin)) *MockStorageClient_WriteChannelPin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*DBChannelPin))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_WriteChannelPin_Call) Return(_a0 error) *MockStorageClient_WriteChannelPin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageClient_WriteChannelPin_Call) RunAndReturn(run func(context.Context, *DBChannelPin) error) *MockStorageClient_WriteChannelPin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteConfigRevision provides a mock function with given fields: ctx, revision
+func (_m *MockStorageClient) WriteConfigRevision(ctx context.Context, revision *DBConfigRevision) error {
+	ret := _m.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteConfigRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *DBConfigRevision) error); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageClient_WriteConfigRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteConfigRevision'
+type MockStorageClient_WriteConfigRevision_Call struct {
+	*mock.Call
+}
+
+// WriteConfigRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision *DBConfigRevision
+func (_e *MockStorageClient_Expecter) WriteConfigRevision(ctx interface{}, revision interface{}) *MockStorageClient_WriteConfigRevision_Call {
+	return &MockStorageClient_WriteConfigRevision_Call{Call: _e.mock.On("WriteConfigRevision", ctx, revision)}
+}
+
+func (_c *MockStorageClient_WriteConfigRevision_Call) Run(run func(ctx context.Context, revision *DBConfigRevision)) *MockStorageClient_WriteConfigRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*DBConfigRevision))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_WriteConfigRevision_Call) Return(_a0 error) *MockStorageClient_WriteConfigRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageClient_WriteConfigRevision_Call) RunAndReturn(run func(context.Context, *DBConfigRevision) error) *MockStorageClient_WriteConfigRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WriteGithubReleaseAsset provides a mock function with given fields: ctx, asset
 func (_m *MockStorageClient) WriteGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) error {
 	ret := _m.Called(ctx, asset)
@@ -277,6 +702,53 @@ func (_c *MockStorageClient_WriteGithubReleaseAsset_Call) RunAndReturn(run func(
 	return _c
 }
 
+// WriteNodeStatus provides a mock function with given fields: ctx, status
+func (_m *MockStorageClient) WriteNodeStatus(ctx context.Context, status *NodeStatus) error {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteNodeStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *NodeStatus) error); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageClient_WriteNodeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteNodeStatus'
+type MockStorageClient_WriteNodeStatus_Call struct {
+	*mock.Call
+}
+
+// WriteNodeStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status *NodeStatus
+func (_e *MockStorageClient_Expecter) WriteNodeStatus(ctx interface{}, status interface{}) *MockStorageClient_WriteNodeStatus_Call {
+	return &MockStorageClient_WriteNodeStatus_Call{Call: _e.mock.On("WriteNodeStatus", ctx, status)}
+}
+
+func (_c *MockStorageClient_WriteNodeStatus_Call) Run(run func(ctx context.Context, status *NodeStatus)) *MockStorageClient_WriteNodeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*NodeStatus))
+	})
+	return _c
+}
+
+func (_c *MockStorageClient_WriteNodeStatus_Call) Return(_a0 error) *MockStorageClient_WriteNodeStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageClient_WriteNodeStatus_Call) RunAndReturn(run func(context.Context, *NodeStatus) error) *MockStorageClient_WriteNodeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStorageClient creates a new instance of MockStorageClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStorageClient(t interface {
@@ -1,5 +1,9 @@
 package controller
 
+import (
+	"time"
+)
+
 type Challenge struct {
 	ID    string `db:"id"`
 	Value string `db:"value"`
@@ -10,4 +14,50 @@ type DBGithubRelease struct {
 	OS          string `db:"os"`
 	Arch        string `db:"arch"`
 	DownloadURL string `db:"download_url"`
+	Sha256      string `db:"sha256"`
+}
+
+type DBNodeStatus struct {
+	NodeID          string    `db:"node_id"`
+	LastSeen        time.Time `db:"last_seen"`
+	LastSyncSuccess bool      `db:"last_sync_success"`
+	AppliedCommit   string    `db:"applied_commit"`
+}
+
+type DBSeedFailure struct {
+	NodeID      string `db:"node_id"`
+	Hash        string `db:"hash"`
+	DisplayName string `db:"display_name"`
+	Error       string `db:"error"`
+}
+
+type NodeStatus struct {
+	DBNodeStatus
+	SeedFailures []DBSeedFailure
+}
+
+// DBChannelPin holds a channel at a commit, overriding whatever it would otherwise track
+type DBChannelPin struct {
+	Channel  string    `db:"channel"`
+	Commit   string    `db:"commit_sha"`
+	PinnedAt time.Time `db:"pinned_at"`
+}
+
+// ConfigTrigger is what caused a config revision to be loaded
+type ConfigTrigger string
+
+const (
+	ConfigTriggerStartup      ConfigTrigger = "startup"
+	ConfigTriggerWebhook      ConfigTrigger = "webhook"
+	ConfigTriggerForceRefresh ConfigTrigger = "force-refresh"
+	ConfigTriggerPin          ConfigTrigger = "pin"
+	ConfigTriggerUnpin        ConfigTrigger = "unpin"
+	ConfigTriggerPoll         ConfigTrigger = "poll"
+)
+
+type DBConfigRevision struct {
+	ID       int64         `db:"id"`
+	Commit   string        `db:"commit_sha"`
+	LoadedAt time.Time     `db:"loaded_at"`
+	Trigger  ConfigTrigger `db:"load_trigger"`
 }
@@ -0,0 +1,220 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+)
+
+var (
+	ErrUnknownReleaseHostError     = errors.New("unknown release host")
+	ErrUnknownReleaseProviderError = errors.New("unknown release provider")
+)
+
+var defaultProviderApiUrls = map[string]string{
+	parsingv2.ReleaseProviderGitlab: DefaultGitlabApiUrl,
+	parsingv2.ReleaseProviderGitea:  DefaultGiteaApiUrl,
+}
+
+// ReleaseHost is an instance release seeds can target, either github.com, a GitHub Enterprise Server, or a GitLab or
+// Gitea instance
+type ReleaseHost struct {
+	// ApiURL is the base of the REST API, i.e https://api.github.com or https://ghe.example.com/api/v3
+	ApiURL string
+	// Token authenticates requests to the host, unauthenticated if empty
+	Token string
+	// TokenSource takes precedence over Token, i.e for GitHub App installation tokens
+	TokenSource GithubTokenSource
+	// Provider is the API the host speaks, taken from the release being looked up. Empty for github
+	Provider string
+}
+
+// anonymous is true if requests to the host are unauthenticated
+func (h ReleaseHost) anonymous() bool {
+	return h.Token == "" && h.TokenSource == nil
+}
+
+// token returns the current credential for the host, empty if there is none
+func (h ReleaseHost) token() (string, error) {
+	if h.TokenSource == nil {
+		return h.Token, nil
+	}
+	token, err := h.TokenSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("error getting github token: %w", err)
+	}
+	return token, nil
+}
+
+// credential identifies the host's credential without holding onto it, staying the same as app installation tokens
+// rotate
+func (h ReleaseHost) credential() string {
+	switch {
+	case h.TokenSource != nil:
+		return githubTokenSourceID(h.TokenSource)
+	case h.Token != "":
+		return StaticGithubToken(h.Token).credentialID()
+	default:
+		return "anonymous"
+	}
+}
+
+// withCredential labels requests made with ctx as using the host's credential, for the transport's response cache
+func (h ReleaseHost) withCredential(ctx context.Context) context.Context {
+	return withGithubCredential(ctx, h.credential())
+}
+
+// authorize adds the host credentials to the request, if there are any
+func (h ReleaseHost) authorize(builder *requests.Builder) (*requests.Builder, error) {
+	token, err := h.token()
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return builder, nil
+	}
+
+	switch h.Provider {
+	case parsingv2.ReleaseProviderGitlab:
+		return builder.Header("PRIVATE-TOKEN", token), nil
+	case parsingv2.ReleaseProviderGitea:
+		return builder.Header("Authorization", "token "+token), nil
+	default:
+		return builder.Header("Authorization", basicAuth("__token__", token)), nil
+	}
+}
+
+// downloadAuthorization is the Authorization header agents send when downloading release assets from the host
+func (h ReleaseHost) downloadAuthorization(token string) string {
+	if h.Provider == parsingv2.ReleaseProviderGitea {
+		return "token " + token
+	}
+	return "Bearer " + token
+}
+
+// servesURL reports if rawURL has the same origin as the host, rather than being somewhere a release links out to.
+// github.com's API lives on api.github.com, so the API host without its "api." prefix counts as well
+func (h ReleaseHost) servesURL(rawURL string) bool {
+	apiURL, err := url.Parse(h.ApiURL)
+	if err != nil {
+		return false
+	}
+	target, err := url.Parse(rawURL)
+	if err != nil || target.Scheme != apiURL.Scheme {
+		return false
+	}
+
+	host := strings.ToLower(target.Host)
+	apiHost := strings.ToLower(apiURL.Host)
+	return host == apiHost || "api."+host == apiHost
+}
+
+// NewReleaseHostsFromEnv reads the named hosts listed in release.hosts, each configured under release.host.<name>. A
+// host may authenticate with a token or as its own GitHub App. Hosts listed in the deprecated github.hosts are read
+// from github.host.<name> instead
+func NewReleaseHostsFromEnv(logger zerolog.Logger) (map[string]ReleaseHost, error) {
+	hosts := map[string]ReleaseHost{}
+
+	read := func(list string, prefix string) error {
+		for _, name := range strings.Split(list, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			if _, ok := hosts[name]; ok {
+				return fmt.Errorf("release host %v is configured more than once", name)
+			}
+
+			host, err := newReleaseHostFromEnv(logger, prefix, name)
+			if err != nil {
+				return fmt.Errorf("release host %v: %w", name, err)
+			}
+			hosts[name] = host
+		}
+		return nil
+	}
+
+	if err := read(viper.GetString(ReleaseHosts), releaseHostPrefix); err != nil {
+		return nil, err
+	}
+	if deprecated := viper.GetString(GithubHosts); deprecated != "" {
+		logger.Warn().Msgf("%v is deprecated, list hosts in %v and configure them under %v.<name>", GithubHosts, ReleaseHosts, releaseHostPrefix)
+		if err := read(deprecated, deprecatedReleaseHostPrefix); err != nil {
+			return nil, err
+		}
+	}
+
+	return hosts, nil
+}
+
+func newReleaseHostFromEnv(logger zerolog.Logger, prefix string, name string) (ReleaseHost, error) {
+	apiURLKey := releaseHostKey(prefix, name, "api_url")
+	host := ReleaseHost{
+		ApiURL: viper.GetString(apiURLKey),
+		Token:  viper.GetString(releaseHostKey(prefix, name, "token")),
+	}
+	if host.ApiURL == "" {
+		return ReleaseHost{}, fmt.Errorf("%v is required", apiURLKey)
+	}
+
+	app, err := newGithubAppFromKeys(
+		logger.With().Str("host", name).Logger(),
+		githubAppKeys{
+			appID:          releaseHostKey(prefix, name, "app.id"),
+			installationID: releaseHostKey(prefix, name, "app.installation_id"),
+			privateKeyPath: releaseHostKey(prefix, name, "app.private_key.path"),
+		},
+		host.ApiURL,
+	)
+	if err != nil {
+		return ReleaseHost{}, err
+	}
+	if app != nil {
+		host.TokenSource = app
+	}
+
+	return host, nil
+}
+
+// apiBaseURL ensures the API base ends in a slash, otherwise relative paths replace its last segment (i.e the v3 in
+// https://ghe.example.com/api/v3)
+func apiBaseURL(u string) string {
+	return strings.TrimSuffix(u, "/") + "/"
+}
+
+// namedReleaseHost returns the named host, the empty name being the default host
+func (c *Controller) namedReleaseHost(name string) (ReleaseHost, error) {
+	host, ok := c.releaseHosts[name]
+	if !ok {
+		return ReleaseHost{}, fmt.Errorf("%w: %v", ErrUnknownReleaseHostError, name)
+	}
+	return host, nil
+}
+
+// releaseHost returns the host a release is published on. Releases on other forges without a named host use the
+// forge's public instance, anonymously
+func (c *Controller) releaseHost(release *parsingv2.GithubRelease) (ReleaseHost, error) {
+	if release.Host == "" && !release.OnGithub() {
+		apiURL, ok := defaultProviderApiUrls[release.Provider]
+		if !ok {
+			return ReleaseHost{}, fmt.Errorf("%w: %v", ErrUnknownReleaseProviderError, release.Provider)
+		}
+		return ReleaseHost{ApiURL: apiBaseURL(apiURL), Provider: release.Provider}, nil
+	}
+
+	host, err := c.namedReleaseHost(release.Host)
+	if err != nil {
+		return ReleaseHost{}, err
+	}
+	if !release.OnGithub() {
+		host.Provider = release.Provider
+	}
+	return host, nil
+}
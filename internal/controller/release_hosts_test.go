@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/internal/parsingv2"
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReleaseHostsFromEnv(t *testing.T) {
+	t.Run("smokes", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+		viper.Set(ReleaseHosts, "ghe, other")
+		viper.Set(ReleaseHostApiUrlKey("ghe"), "https://ghe.example.com/api/v3")
+		viper.Set(ReleaseHostTokenKey("ghe"), "some-ghe-token")
+		viper.Set(ReleaseHostApiUrlKey("other"), "https://other.example.com/api/v3")
+
+		hosts, err := NewReleaseHostsFromEnv(zerolog.Nop())
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[string]ReleaseHost{
+				"ghe":   {ApiURL: "https://ghe.example.com/api/v3", Token: "some-ghe-token"},
+				"other": {ApiURL: "https://other.example.com/api/v3"},
+			},
+			hosts,
+		)
+	})
+
+	t.Run("missing api url", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+		viper.Set(ReleaseHosts, "ghe")
+
+		_, err := NewReleaseHostsFromEnv(zerolog.Nop())
+		require.ErrorContains(t, err, "release.host.ghe.api_url is required")
+	})
+
+	t.Run("deprecated github hosts", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+		viper.Set(ReleaseHosts, "gitlab")
+		viper.Set(ReleaseHostApiUrlKey("gitlab"), "https://gitlab.example.com/api/v4")
+		viper.Set(GithubHosts, "ghe")
+		viper.Set("github.host.ghe.api_url", "https://ghe.example.com/api/v3")
+		viper.Set("github.host.ghe.token", "some-ghe-token")
+
+		hosts, err := NewReleaseHostsFromEnv(zerolog.Nop())
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[string]ReleaseHost{
+				"gitlab": {ApiURL: "https://gitlab.example.com/api/v4"},
+				"ghe":    {ApiURL: "https://ghe.example.com/api/v3", Token: "some-ghe-token"},
+			},
+			hosts,
+		)
+	})
+
+	t.Run("configured twice", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+		viper.Set(ReleaseHosts, "ghe")
+		viper.Set(ReleaseHostApiUrlKey("ghe"), "https://ghe.example.com/api/v3")
+		viper.Set(GithubHosts, "ghe")
+
+		_, err := NewReleaseHostsFromEnv(zerolog.Nop())
+		require.ErrorContains(t, err, "release host ghe is configured more than once")
+	})
+
+	t.Run("app auth", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		keyPath := filepath.Join(t.TempDir(), "app.pem")
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+		require.NoError(t, os.WriteFile(keyPath, keyPEM, 0600))
+
+		viper.Set(ReleaseHosts, "ghe")
+		viper.Set(ReleaseHostApiUrlKey("ghe"), "https://ghe.example.com/api/v3")
+		viper.Set(ReleaseHostAppIDKey("ghe"), "12")
+		viper.Set(ReleaseHostAppInstallationIDKey("ghe"), "99")
+		viper.Set(ReleaseHostAppPrivateKeyPathKey("ghe"), keyPath)
+
+		hosts, err := NewReleaseHostsFromEnv(zerolog.Nop())
+		require.NoError(t, err)
+
+		app, ok := hosts["ghe"].TokenSource.(*GithubApp)
+		require.True(t, ok)
+		require.Equal(t, int64(12), app.appID)
+		require.Equal(t, int64(99), app.installationID)
+		require.Equal(t, "https://ghe.example.com/api/v3/", app.apiURL)
+	})
+
+	t.Run("non numeric app id", func(t *testing.T) {
+		t.Cleanup(viper.Reset)
+		viper.Set(ReleaseHosts, "ghe")
+		viper.Set(ReleaseHostApiUrlKey("ghe"), "https://ghe.example.com/api/v3")
+		viper.Set(ReleaseHostAppIDKey("ghe"), "my-app")
+
+		_, err := NewReleaseHostsFromEnv(zerolog.Nop())
+		require.ErrorContains(t, err, `release host ghe: release.host.ghe.app.id must be a positive number, got "my-app"`)
+	})
+}
+
+func TestGithubRelease_NamedHost(t *testing.T) {
+	t.Parallel()
+
+	const (
+		releaseURL = "https://ghe.example.com/api/v3/repos/some/repo/releases/tags/v1.0.0"
+		assetURL   = "https://ghe.example.com/some/repo/releases/download/v1.0.0/some-linux-amd64"
+	)
+
+	node := &parsingv2.Node{OS: "linux", Arch: "amd64"}
+
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(
+		http.MethodGet,
+		releaseURL,
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, basicAuth("__token__", "some-ghe-token"), req.Header.Get("Authorization"))
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"assets": []map[string]any{
+					{"name": "some-linux-amd64", "browser_download_url": assetURL},
+				},
+			})
+		},
+	)
+
+	storage := NewMockStorageClient(t)
+	storage.EXPECT().ReadGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	storage.EXPECT().WriteGithubReleaseAsset(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	ctrl := newControllerWithConfig(
+		t,
+		ControllerConfig{
+			StorageClient:      storage,
+			HttpClient:         &http.Client{Transport: mockTransport},
+			GithubReleaseToken: "some-default-token",
+			ReleaseHosts: map[string]ReleaseHost{
+				"ghe": {ApiURL: "https://ghe.example.com/api/v3", Token: "some-ghe-token"},
+			},
+		},
+		nil,
+	)
+
+	t.Run("uses host api and credentials", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{Repo: "some/repo", Tag: "v1.0.0", Host: "ghe"},
+			node,
+		)
+		require.NoError(t, err)
+		pbEqual(
+			t,
+			&pbv1.Seed{
+				Element: &pbv1.Seed_GithubRelease{
+					GithubRelease: &pbv1.GithubRelease{
+						DownloadUrl: assetURL,
+						Authentication: &pbv1.GithubRelease_Authentication{
+							BearerAuth: "Bearer some-ghe-token",
+						},
+					},
+				},
+			},
+			got,
+		)
+	})
+
+	t.Run("unknown host", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ctrl.renderSeed_githubRelease(
+			context.Background(),
+			&parsingv2.GithubRelease{Repo: "some/repo", Tag: "v1.0.0", Host: "nope"},
+			node,
+		)
+		require.ErrorIs(t, err, ErrUnknownReleaseHostError)
+	})
+}
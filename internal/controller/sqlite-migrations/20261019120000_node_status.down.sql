@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS node_seed_failure;
+DROP TABLE IF EXISTS node_status;
+
+COMMIT;
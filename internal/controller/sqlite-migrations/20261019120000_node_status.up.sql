@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE node_status (
+    node_id           TEXT NOT NULL PRIMARY KEY,
+    last_seen         TIMESTAMP NOT NULL,
+    last_sync_success BOOLEAN NOT NULL,
+    applied_commit    TEXT NOT NULL
+);
+
+CREATE TABLE node_seed_failure (
+    node_id      TEXT NOT NULL REFERENCES node_status(node_id) ON DELETE CASCADE,
+    hash         TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    error        TEXT NOT NULL,
+    PRIMARY KEY (node_id, hash)
+);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE github_release_asset DROP COLUMN sha256;
+
+COMMIT;
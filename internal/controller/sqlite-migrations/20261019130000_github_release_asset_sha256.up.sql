@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE github_release_asset ADD COLUMN sha256 TEXT NOT NULL DEFAULT '';
+
+COMMIT;
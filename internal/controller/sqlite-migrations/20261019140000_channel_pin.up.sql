@@ -0,0 +1,9 @@
+BEGIN;
+
+CREATE TABLE channel_pin (
+    channel    TEXT NOT NULL PRIMARY KEY,
+    commit_sha TEXT NOT NULL,
+    pinned_at  TIMESTAMP NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE TABLE config_revision (
+    id           INTEGER PRIMARY KEY,
+    commit_sha   TEXT NOT NULL,
+    loaded_at    TIMESTAMP NOT NULL,
+    load_trigger TEXT NOT NULL
+);
+
+COMMIT;
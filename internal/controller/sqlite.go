@@ -45,7 +45,11 @@ func (s *SqlLite) init() error {
 func (s *SqlLite) Purge(ctx context.Context) error {
 	tables := []string{
 		"challenge",
+		"channel_pin",
+		"config_revision",
 		"github_release_asset",
+		"node_seed_failure",
+		"node_status",
 	}
 	err := hsqlx.WithTransaction(s.db, func(txn *sqlx.Tx) error {
 		for _, tbl := range tables {
@@ -136,14 +140,16 @@ func (s *SqlLite) WriteGithubReleaseAsset(ctx context.Context, release *DBGithub
 				hash,
 				os,
 				arch,
-				download_url
+				download_url,
+				sha256
 			)
 		VALUES
 			(
 				:hash,
 				:os,
 				:arch,
-				:download_url
+				:download_url,
+				:sha256
 			)
 	`
 	if _, err := s.db.NamedExecContext(ctx, stmt, release); err != nil {
@@ -152,7 +158,7 @@ func (s *SqlLite) WriteGithubReleaseAsset(ctx context.Context, release *DBGithub
 	return nil
 }
 
-func (s *SqlLite) ReadGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) (string, error) {
+func (s *SqlLite) ReadGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) (*DBGithubRelease, error) {
 	stmt := `
 		SELECT
 			*
@@ -166,9 +172,295 @@ func (s *SqlLite) ReadGithubReleaseAsset(ctx context.Context, asset *DBGithubRel
 	rows, err := hsqlx.RequireExactSelectNamedCtx[DBGithubRelease](ctx, 1, s.db, stmt, asset)
 	if err != nil {
 		if errors.Is(err, hsqlx.ErrNotFoundError) {
-			return "", nil
+			return nil, nil
 		}
-		return "", fmt.Errorf("error selecting: %w", err)
+		return nil, fmt.Errorf("error selecting: %w", err)
 	}
-	return rows[0].DownloadURL, nil
+	return &rows[0], nil
+}
+
+func (s *SqlLite) WriteNodeStatus(ctx context.Context, status *NodeStatus) error {
+	return hsqlx.WithTransaction(s.db, func(txn *sqlx.Tx) error {
+		stmt := `
+			INSERT OR REPLACE INTO
+				node_status
+				(
+					node_id,
+					last_seen,
+					last_sync_success,
+					applied_commit
+				)
+			VALUES
+				(
+					:node_id,
+					:last_seen,
+					:last_sync_success,
+					:applied_commit
+				)
+		`
+		if _, err := txn.NamedExecContext(ctx, stmt, status.DBNodeStatus); err != nil {
+			return fmt.Errorf("error upserting status: %w", err)
+		}
+
+		// Failures are replaced wholesale on every report, so clear out the previous set first
+		stmt = `
+			DELETE FROM
+				node_seed_failure
+			WHERE
+				node_id = :node_id
+		`
+		if _, err := txn.NamedExecContext(ctx, stmt, status.DBNodeStatus); err != nil {
+			return fmt.Errorf("error deleting old failures: %w", err)
+		}
+
+		stmt = `
+			INSERT INTO
+				node_seed_failure
+				(
+					node_id,
+					hash,
+					display_name,
+					error
+				)
+			VALUES
+				(
+					:node_id,
+					:hash,
+					:display_name,
+					:error
+				)
+		`
+		for _, failure := range status.SeedFailures {
+			failure.NodeID = status.NodeID
+			if _, err := txn.NamedExecContext(ctx, stmt, failure); err != nil {
+				return fmt.Errorf("error inserting failure: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *SqlLite) ReadNodeStatus(ctx context.Context, nodeID string) (*NodeStatus, error) {
+	stmt := `
+		SELECT
+			*
+		FROM
+			node_status
+		WHERE
+			node_id = :node_id
+	`
+	args := map[string]any{
+		"node_id": nodeID,
+	}
+
+	rows, err := hsqlx.RequireExactSelectNamedCtx[DBNodeStatus](ctx, 1, s.db, stmt, args)
+	if err != nil {
+		if errors.Is(err, hsqlx.ErrNotFoundError) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error selecting status: %w", err)
+	}
+
+	failures, err := s.readSeedFailures(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeStatus{
+		DBNodeStatus: rows[0],
+		SeedFailures: failures[nodeID],
+	}, nil
+}
+
+func (s *SqlLite) ReadNodeStatuses(ctx context.Context) ([]NodeStatus, error) {
+	stmt := `
+		SELECT
+			*
+		FROM
+			node_status
+		ORDER BY
+			node_id
+	`
+
+	rows := []DBNodeStatus{}
+	if err := s.db.SelectContext(ctx, &rows, stmt); err != nil {
+		return nil, fmt.Errorf("error selecting statuses: %w", err)
+	}
+
+	failures, err := s.readSeedFailures(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]NodeStatus, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, NodeStatus{
+			DBNodeStatus: row,
+			SeedFailures: failures[row.NodeID],
+		})
+	}
+
+	return out, nil
+}
+
+func (s *SqlLite) WriteChannelPin(ctx context.Context, pin *DBChannelPin) error {
+	stmt := `
+		INSERT OR REPLACE INTO
+			channel_pin
+			(
+				channel,
+				commit_sha,
+				pinned_at
+			)
+		VALUES
+			(
+				:channel,
+				:commit_sha,
+				:pinned_at
+			)
+	`
+	if _, err := s.db.NamedExecContext(ctx, stmt, pin); err != nil {
+		return fmt.Errorf("error upserting pin: %w", err)
+	}
+	return nil
+}
+
+func (s *SqlLite) ReadChannelPin(ctx context.Context, channel string) (*DBChannelPin, error) {
+	stmt := `
+		SELECT
+			*
+		FROM
+			channel_pin
+		WHERE
+			channel = :channel
+	`
+	args := map[string]any{
+		"channel": channel,
+	}
+
+	rows, err := hsqlx.RequireExactSelectNamedCtx[DBChannelPin](ctx, 1, s.db, stmt, args)
+	if err != nil {
+		if errors.Is(err, hsqlx.ErrNotFoundError) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error selecting pin: %w", err)
+	}
+	return &rows[0], nil
+}
+
+func (s *SqlLite) DeleteChannelPin(ctx context.Context, channel string) error {
+	stmt := `
+		DELETE FROM
+			channel_pin
+		WHERE
+			channel = :channel
+	`
+	args := map[string]any{
+		"channel": channel,
+	}
+
+	if _, err := s.db.NamedExecContext(ctx, stmt, args); err != nil {
+		return fmt.Errorf("error deleting pin: %w", err)
+	}
+	return nil
+}
+
+func (s *SqlLite) WriteConfigRevision(ctx context.Context, revision *DBConfigRevision) error {
+	stmt := `
+		INSERT INTO
+			config_revision
+			(
+				commit_sha,
+				loaded_at,
+				load_trigger
+			)
+		VALUES
+			(
+				:commit_sha,
+				:loaded_at,
+				:load_trigger
+			)
+	`
+	if _, err := s.db.NamedExecContext(ctx, stmt, revision); err != nil {
+		return fmt.Errorf("error inserting revision: %w", err)
+	}
+	return nil
+}
+
+func (s *SqlLite) ReadConfigRevisions(ctx context.Context, limit int) ([]DBConfigRevision, error) {
+	stmt := `
+		SELECT
+			*
+		FROM
+			config_revision
+		ORDER BY
+			id DESC
+		LIMIT
+			?
+	`
+
+	rows := []DBConfigRevision{}
+	if err := s.db.SelectContext(ctx, &rows, stmt, limit); err != nil {
+		return nil, fmt.Errorf("error selecting revisions: %w", err)
+	}
+	return rows, nil
+}
+
+func (s *SqlLite) PruneConfigRevisions(ctx context.Context, keep int) error {
+	stmt := `
+		DELETE FROM
+			config_revision
+		WHERE
+			id NOT IN (
+				SELECT
+					id
+				FROM
+					config_revision
+				ORDER BY
+					id DESC
+				LIMIT
+					?
+			)
+	`
+
+	if _, err := s.db.ExecContext(ctx, stmt, keep); err != nil {
+		return fmt.Errorf("error pruning revisions: %w", err)
+	}
+	return nil
+}
+
+// readSeedFailures reads seed failures grouped by node, limited to a single node if nodeID is non-empty
+func (s *SqlLite) readSeedFailures(ctx context.Context, nodeID string) (map[string][]DBSeedFailure, error) {
+	stmt := `
+		SELECT
+			*
+		FROM
+			node_seed_failure
+		WHERE
+			:node_id = '' OR node_id = :node_id
+		ORDER BY
+			node_id,
+			display_name
+	`
+	args := map[string]any{
+		"node_id": nodeID,
+	}
+
+	query, queryArgs, err := sqlx.Named(stmt, args)
+	if err != nil {
+		return nil, fmt.Errorf("error binding args: %w", err)
+	}
+
+	rows := []DBSeedFailure{}
+	if err := s.db.SelectContext(ctx, &rows, s.db.Rebind(query), queryArgs...); err != nil {
+		return nil, fmt.Errorf("error selecting failures: %w", err)
+	}
+
+	out := map[string][]DBSeedFailure{}
+	for _, row := range rows {
+		out[row.NodeID] = append(out[row.NodeID], row)
+	}
+
+	return out, nil
 }
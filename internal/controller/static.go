@@ -33,6 +33,10 @@ func (s *StaticGitClient) GetLatestCommit(url string) (string, error) {
 	return "not-a-real-commit", nil
 }
 
+func (s *StaticGitClient) GetBranchCommit(url string, branch string) (string, error) {
+	return "not-a-real-commit", nil
+}
+
 func (s *StaticGitClient) CloneAtCommit(url string, commit string) (fs.FS, error) {
 	return os.DirFS(s.checkoutPath), nil
 }
@@ -40,3 +44,7 @@ func (s *StaticGitClient) CloneAtCommit(url string, commit string) (fs.FS, error
 func (s *StaticGitClient) GetLatestRelease(url string) (string, error) {
 	return "fake-tag", nil
 }
+
+func (s *StaticGitClient) ListTags(url string) ([]GitTag, error) {
+	return nil, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
@@ -22,10 +23,15 @@ func TestStorageIntegration(t *testing.T) {
 			challengeID    = "some-challenge-id"
 			challengeValue = "some-challenge-value"
 
-			assetHash = "asset-hash"
-			assetOS   = "asset-os"
-			assetArch = "asset-arch"
-			assetURL  = "asset-url"
+			assetHash   = "asset-hash"
+			assetOS     = "asset-os"
+			assetArch   = "asset-arch"
+			assetURL    = "asset-url"
+			assetSha256 = "asset-sha256"
+
+			nodeID = "some-node-id"
+
+			channel = "some-channel"
 		)
 
 		// Start by purging everything, just in case
@@ -48,6 +54,7 @@ func TestStorageIntegration(t *testing.T) {
 			OS:          assetOS,
 			Arch:        assetArch,
 			DownloadURL: assetURL,
+			Sha256:      assetSha256,
 		}))
 
 		// Read the asset back
@@ -57,7 +64,108 @@ func TestStorageIntegration(t *testing.T) {
 			Arch: assetArch,
 		})
 		require.NoError(t, err)
-		require.Equal(t, assetURL, gotAsset)
+		require.Equal(
+			t,
+			&DBGithubRelease{
+				Hash:        assetHash,
+				OS:          assetOS,
+				Arch:        assetArch,
+				DownloadURL: assetURL,
+				Sha256:      assetSha256,
+			},
+			gotAsset,
+		)
+
+		// Read a node status that doesnt exist
+		gotStatus, err := store.ReadNodeStatus(ctx, nodeID)
+		require.NoError(t, err)
+		require.Nil(t, gotStatus)
+
+		// Write a node status
+		status := &NodeStatus{
+			DBNodeStatus: DBNodeStatus{
+				NodeID:          nodeID,
+				LastSeen:        time.Date(2095, time.May, 15, 15, 30, 0, 0, time.UTC),
+				LastSyncSuccess: false,
+				AppliedCommit:   "some-commit",
+			},
+			SeedFailures: []DBSeedFailure{
+				{NodeID: nodeID, Hash: "hash-one", DisplayName: "seed-one", Error: "error-one"},
+			},
+		}
+		require.NoError(t, store.WriteNodeStatus(ctx, status))
+
+		// Read it back
+		gotStatus, err = store.ReadNodeStatus(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, status, gotStatus)
+
+		// Overwrite it with a successful sync, which should clear out the failures
+		status.LastSyncSuccess = true
+		status.SeedFailures = nil
+		require.NoError(t, store.WriteNodeStatus(ctx, status))
+
+		gotStatuses, err := store.ReadNodeStatuses(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []NodeStatus{*status}, gotStatuses)
+
+		// Read a pin that doesnt exist
+		gotPin, err := store.ReadChannelPin(ctx, channel)
+		require.NoError(t, err)
+		require.Nil(t, gotPin)
+
+		// Pin a channel, then move it along
+		pin := &DBChannelPin{
+			Channel:  channel,
+			Commit:   "some-commit",
+			PinnedAt: time.Date(2095, time.May, 15, 15, 30, 0, 0, time.UTC),
+		}
+		require.NoError(t, store.WriteChannelPin(ctx, pin))
+		pin.Commit = "other-commit"
+		require.NoError(t, store.WriteChannelPin(ctx, pin))
+
+		gotPin, err = store.ReadChannelPin(ctx, channel)
+		require.NoError(t, err)
+		require.Equal(t, pin, gotPin)
+
+		// Unpin it
+		require.NoError(t, store.DeleteChannelPin(ctx, channel))
+		gotPin, err = store.ReadChannelPin(ctx, channel)
+		require.NoError(t, err)
+		require.Nil(t, gotPin)
+
+		// Record a few revisions, which come back newest first
+		loadedAt := time.Date(2095, time.May, 15, 15, 30, 0, 0, time.UTC)
+		for _, revision := range []DBConfigRevision{
+			{Commit: "commit-one", LoadedAt: loadedAt, Trigger: ConfigTriggerStartup},
+			{Commit: "commit-two", LoadedAt: loadedAt, Trigger: ConfigTriggerWebhook},
+			{Commit: "commit-one", LoadedAt: loadedAt, Trigger: ConfigTriggerPin},
+		} {
+			require.NoError(t, store.WriteConfigRevision(ctx, &revision))
+		}
+
+		gotRevisions, err := store.ReadConfigRevisions(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]DBConfigRevision{
+				{ID: 3, Commit: "commit-one", LoadedAt: loadedAt, Trigger: ConfigTriggerPin},
+				{ID: 2, Commit: "commit-two", LoadedAt: loadedAt, Trigger: ConfigTriggerWebhook},
+			},
+			gotRevisions,
+		)
+
+		// Pruning keeps the newest
+		require.NoError(t, store.PruneConfigRevisions(ctx, 1))
+		gotRevisions, err = store.ReadConfigRevisions(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]DBConfigRevision{
+				{ID: 3, Commit: "commit-one", LoadedAt: loadedAt, Trigger: ConfigTriggerPin},
+			},
+			gotRevisions,
+		)
 	}
 
 	t.Run("sqlite", func(t *testing.T) {
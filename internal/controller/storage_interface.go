@@ -15,7 +15,18 @@ type StorageClient interface {
 	WriteChallenge(ctx context.Context, challenge *Challenge) error
 	ReadChallenge(ctx context.Context, id string) (*Challenge, error)
 	WriteGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) error
-	ReadGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) (string, error)
+	ReadGithubReleaseAsset(ctx context.Context, asset *DBGithubRelease) (*DBGithubRelease, error)
+	WriteNodeStatus(ctx context.Context, status *NodeStatus) error
+	ReadNodeStatus(ctx context.Context, nodeID string) (*NodeStatus, error)
+	ReadNodeStatuses(ctx context.Context) ([]NodeStatus, error)
+	WriteChannelPin(ctx context.Context, pin *DBChannelPin) error
+	ReadChannelPin(ctx context.Context, channel string) (*DBChannelPin, error)
+	DeleteChannelPin(ctx context.Context, channel string) error
+	WriteConfigRevision(ctx context.Context, revision *DBConfigRevision) error
+	// ReadConfigRevisions returns up to limit revisions, newest first
+	ReadConfigRevisions(ctx context.Context, limit int) ([]DBConfigRevision, error)
+	// PruneConfigRevisions deletes all but the newest keep revisions
+	PruneConfigRevisions(ctx context.Context, keep int) error
 }
 
 func NewStorageClientFromEnv(logger zerolog.Logger) (StorageClient, func(), error) {
@@ -0,0 +1,55 @@
+package interceptors
+
+import (
+	"slices"
+
+	"github.com/nicjohnson145/plantr/gen/plantr/agent/v1/agentv1connect"
+	"github.com/nicjohnson145/plantr/gen/plantr/controller/v1/controllerv1connect"
+	"github.com/nicjohnson145/plantr/internal/token"
+)
+
+// Permission describes who is allowed to call a procedure
+type Permission struct {
+	public bool
+	roles  []token.Role
+}
+
+// AllowPublic permits unauthenticated callers
+func AllowPublic() Permission {
+	return Permission{public: true}
+}
+
+// AllowRoles permits authenticated callers holding any of the given roles
+func AllowRoles(roles ...token.Role) Permission {
+	return Permission{roles: roles}
+}
+
+func (p Permission) allows(role token.Role) bool {
+	return slices.Contains(p.roles, role)
+}
+
+// AuthorizationTable maps fully qualified procedure names to who may call them. Procedures missing from the table are
+// denied
+type AuthorizationTable map[string]Permission
+
+var ControllerAuthorizationTable = AuthorizationTable{
+	controllerv1connect.ControllerServiceLoginProcedure:               AllowPublic(),
+	controllerv1connect.ControllerServiceGetSyncDataProcedure:         AllowRoles(token.RoleNode),
+	controllerv1connect.ControllerServiceReportSyncProcedure:          AllowRoles(token.RoleNode),
+	controllerv1connect.ControllerServiceForceRefreshProcedure:        AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceListNodesProcedure:           AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceGetNodeStatusProcedure:       AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceIssueAgentTokenProcedure:     AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceListOutdatedProcedure:        AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceGetGithubRateLimitsProcedure: AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServicePromoteChannelProcedure:      AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceResetChannelProcedure:        AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceListConfigRevisionsProcedure: AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServicePinConfigProcedure:           AllowRoles(token.RoleAdmin),
+	controllerv1connect.ControllerServiceUnpinConfigProcedure:         AllowRoles(token.RoleAdmin),
+}
+
+var AgentAuthorizationTable = AuthorizationTable{
+	agentv1connect.AgentServiceSyncProcedure:       AllowRoles(token.RoleController),
+	agentv1connect.AgentServiceSyncStreamProcedure: AllowRoles(token.RoleController),
+}
@@ -2,11 +2,13 @@ package interceptors
 
 import (
 	"context"
+	"crypto/rsa"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"connectrpc.com/connect"
-	"github.com/nicjohnson145/hlp/set"
 	"github.com/nicjohnson145/plantr/internal/token"
 	"github.com/rs/zerolog"
 )
@@ -22,42 +24,139 @@ const (
 	tokenHeader = "authorization"
 )
 
-func NewAuthInterceptor(logger zerolog.Logger, signingKey []byte, excludedMethods *set.Set[string]) connect.UnaryInterceptorFunc {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			if excludedMethods.Contains(req.Spec().Procedure) {
-				return next(ctx, req)
-			}
-
-			// Check the header exists
-			tokenStr := req.Header().Get(tokenHeader)
-			if tokenStr == "" {
-				logger.Error().Msg("no token provided")
-				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("no token provided"))
-			}
-
-			// Parse out the token
-			token, err := token.ParseJWT(tokenStr, signingKey)
-			if err != nil {
-				logger.Err(err).Msg("error parsing token")
-				return nil, connect.NewError(connect.CodePermissionDenied, err)
-			}
-
-			// Add the parsed token to the context
-			return next(context.WithValue(ctx, claimsKey{}, token), req)
-		})
+type AuthInterceptorConfig struct {
+	SigningKey    []byte
+	PublicKey     *rsa.PublicKey // when set, tokens must be RS256 signed and SigningKey is ignored
+	Audience      string         // when set, tokens must be issued for this audience
+	AdminAPIKey   []byte
+	Authorization AuthorizationTable
+}
+
+func NewAuthInterceptor(logger zerolog.Logger, conf AuthInterceptorConfig) *AuthInterceptor {
+	return &AuthInterceptor{
+		log:  logger,
+		conf: conf,
+	}
+}
+
+// AuthInterceptor authenticates callers and checks them against the authorization table, for both unary and streaming
+// procedures
+type AuthInterceptor struct {
+	log  zerolog.Logger
+	conf AuthInterceptorConfig
+}
+
+var _ connect.Interceptor = (*AuthInterceptor)(nil)
+
+func (a *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := a.authorize(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	})
+}
+
+func (a *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := a.authorize(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	})
+}
+
+func (a *AuthInterceptor) authorize(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	permission, ok := a.conf.Authorization[procedure]
+	if !ok {
+		a.log.Error().Msgf("no authorization rule for %v", procedure)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+	if permission.public {
+		return ctx, nil
+	}
+
+	// Check the header exists
+	tokenStr := header.Get(tokenHeader)
+	if tokenStr == "" {
+		a.log.Error().Msg("no token provided")
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("no token provided"))
+	}
+
+	// Figure out who is calling
+	principal, err := resolvePrincipal(tokenStr, a.conf)
+	if err != nil {
+		a.log.Err(err).Msg("error parsing token")
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if !permission.allows(principal.GetRole()) {
+		a.log.Error().Msgf("role %v not permitted to call %v", principal.GetRole(), procedure)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
+	}
+
+	// Add the parsed token to the context
+	return context.WithValue(ctx, claimsKey{}, principal), nil
+}
+
+// resolvePrincipal turns the value of the authorization header into a token, either the static admin api key or a
+// signed JWT
+func resolvePrincipal(tokenStr string, conf AuthInterceptorConfig) (*token.Token, error) {
+	if len(conf.AdminAPIKey) != 0 && subtle.ConstantTimeCompare([]byte(tokenStr), conf.AdminAPIKey) == 1 {
+		return &token.Token{Role: token.RoleAdmin}, nil
+	}
+
+	var tok *token.Token
+	var err error
+	if conf.PublicKey != nil {
+		tok, err = token.ParseJWTRSA(tokenStr, conf.PublicKey)
+	} else {
+		tok, err = token.ParseJWT(tokenStr, conf.SigningKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Audience != "" && !tok.VerifyAudience(conf.Audience, true) {
+		return nil, fmt.Errorf("token not issued for %v", conf.Audience)
+	}
+
+	return tok, nil
+}
+
+func NewClientAuthInterceptor(token string) connect.Interceptor {
+	return &clientAuthInterceptor{token: token}
+}
+
+type clientAuthInterceptor struct {
+	token string
+}
+
+func (c *clientAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set(tokenHeader, c.token)
+		return next(ctx, req)
 	})
 }
 
-func NewClientAuthInterceptor(token string) connect.UnaryInterceptorFunc {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			req.Header().Set(tokenHeader, token)
-			return next(ctx, req)
-		})
+func (c *clientAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set(tokenHeader, c.token)
+		return conn
 	})
 }
 
+func (c *clientAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
 func ClaimsFromCtx(ctx context.Context) (*token.Token, error) {
 	val := ctx.Value(claimsKey{})
 	if val == nil {
@@ -0,0 +1,307 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt"
+	agentv1 "github.com/nicjohnson145/plantr/gen/plantr/agent/v1"
+	"github.com/nicjohnson145/plantr/gen/plantr/agent/v1/agentv1connect"
+	pbv1 "github.com/nicjohnson145/plantr/gen/plantr/controller/v1"
+	"github.com/nicjohnson145/plantr/gen/plantr/controller/v1/controllerv1connect"
+	"github.com/nicjohnson145/plantr/internal/token"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeController struct {
+	controllerv1connect.UnimplementedControllerServiceHandler
+}
+
+func (f *fakeController) Login(context.Context, *connect.Request[pbv1.LoginRequest]) (*connect.Response[pbv1.LoginResponse], error) {
+	return connect.NewResponse(&pbv1.LoginResponse{}), nil
+}
+
+func (f *fakeController) GetSyncData(context.Context, *connect.Request[pbv1.GetSyncDataRequest]) (*connect.Response[pbv1.GetSyncDataResponse], error) {
+	return connect.NewResponse(&pbv1.GetSyncDataResponse{}), nil
+}
+
+func (f *fakeController) ListNodes(context.Context, *connect.Request[pbv1.ListNodesRequest]) (*connect.Response[pbv1.ListNodesResponse], error) {
+	return connect.NewResponse(&pbv1.ListNodesResponse{}), nil
+}
+
+type fakeAgent struct {
+	agentv1connect.UnimplementedAgentServiceHandler
+}
+
+func (f *fakeAgent) Sync(context.Context, *connect.Request[agentv1.SyncRequest]) (*connect.Response[agentv1.SyncResponse], error) {
+	return connect.NewResponse(&agentv1.SyncResponse{}), nil
+}
+
+func (f *fakeAgent) SyncStream(_ context.Context, _ *connect.Request[agentv1.SyncStreamRequest], stream *connect.ServerStream[agentv1.SyncEvent]) error {
+	return stream.Send(&agentv1.SyncEvent{Kind: agentv1.SyncEventKind_SYNC_EVENT_KIND_SYNC_FINISHED})
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		signingKey = []byte(`some-signing-key`)
+		adminKey   = "some-admin-key"
+	)
+
+	newClient := func(t *testing.T, table AuthorizationTable) controllerv1connect.ControllerServiceClient {
+		t.Helper()
+
+		mux := http.NewServeMux()
+		mux.Handle(controllerv1connect.NewControllerServiceHandler(
+			&fakeController{},
+			connect.WithInterceptors(NewAuthInterceptor(zerolog.Nop(), AuthInterceptorConfig{
+				SigningKey:    signingKey,
+				AdminAPIKey:   []byte(adminKey),
+				Authorization: table,
+			})),
+		))
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+
+		return controllerv1connect.NewControllerServiceClient(srv.Client(), srv.URL)
+	}
+
+	signedToken := func(t *testing.T, tok token.Token) string {
+		t.Helper()
+
+		str, err := token.GenerateJWT(signingKey, tok)
+		require.NoError(t, err)
+		return str
+	}
+
+	withAuth := func(req connect.AnyRequest, value string) {
+		req.Header().Set("authorization", value)
+	}
+
+	t.Run("public procedure needs no token", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		_, err := client.Login(context.Background(), connect.NewRequest(&pbv1.LoginRequest{}))
+		require.NoError(t, err)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		_, err := client.GetSyncData(context.Background(), connect.NewRequest(&pbv1.GetSyncDataRequest{}))
+		require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("node token can call node procedure", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		req := connect.NewRequest(&pbv1.GetSyncDataRequest{})
+		withAuth(req, signedToken(t, token.Token{NodeID: "some-node", Role: token.RoleNode}))
+
+		_, err := client.GetSyncData(context.Background(), req)
+		require.NoError(t, err)
+	})
+
+	t.Run("node token cannot call admin procedure", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		req := connect.NewRequest(&pbv1.ListNodesRequest{})
+		withAuth(req, signedToken(t, token.Token{NodeID: "some-node"}))
+
+		_, err := client.ListNodes(context.Background(), req)
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("admin api key can call admin procedure", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		req := connect.NewRequest(&pbv1.ListNodesRequest{})
+		withAuth(req, adminKey)
+
+		_, err := client.ListNodes(context.Background(), req)
+		require.NoError(t, err)
+	})
+
+	t.Run("admin api key cannot call node procedure", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		req := connect.NewRequest(&pbv1.GetSyncDataRequest{})
+		withAuth(req, adminKey)
+
+		_, err := client.GetSyncData(context.Background(), req)
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("signed admin token can call admin procedure", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		req := connect.NewRequest(&pbv1.ListNodesRequest{})
+		withAuth(req, signedToken(t, token.Token{Role: token.RoleAdmin}))
+
+		_, err := client.ListNodes(context.Background(), req)
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, ControllerAuthorizationTable)
+		req := connect.NewRequest(&pbv1.ListNodesRequest{})
+		withAuth(req, "not-the-key")
+
+		_, err := client.ListNodes(context.Background(), req)
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("procedure missing from table is denied", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, AuthorizationTable{})
+		_, err := client.Login(context.Background(), connect.NewRequest(&pbv1.LoginRequest{}))
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
+
+func TestAuthInterceptor_Agent(t *testing.T) {
+	t.Parallel()
+
+	nodeID := "some-node-id"
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle(agentv1connect.NewAgentServiceHandler(
+		&fakeAgent{},
+		connect.WithInterceptors(NewAuthInterceptor(zerolog.Nop(), AuthInterceptorConfig{
+			PublicKey:     &key.PublicKey,
+			Audience:      nodeID,
+			Authorization: AgentAuthorizationTable,
+		})),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := agentv1connect.NewAgentServiceClient(srv.Client(), srv.URL)
+
+	sync := func(t *testing.T, signingKey *rsa.PrivateKey, tok token.Token) error {
+		t.Helper()
+
+		tokenStr, err := token.GenerateJWTRSA(signingKey, tok)
+		require.NoError(t, err)
+
+		req := connect.NewRequest(&agentv1.SyncRequest{})
+		req.Header().Set("authorization", tokenStr)
+		_, err = client.Sync(context.Background(), req)
+		return err
+	}
+
+	t.Run("controller token", func(t *testing.T) {
+		t.Parallel()
+
+		err := sync(t, key, token.Token{
+			StandardClaims: jwt.StandardClaims{Audience: nodeID},
+			Role:           token.RoleController,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("token for another node", func(t *testing.T) {
+		t.Parallel()
+
+		err := sync(t, key, token.Token{
+			StandardClaims: jwt.StandardClaims{Audience: "some-other-node"},
+			Role:           token.RoleController,
+		})
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("node token", func(t *testing.T) {
+		t.Parallel()
+
+		err := sync(t, key, token.Token{
+			StandardClaims: jwt.StandardClaims{Audience: nodeID},
+			NodeID:         nodeID,
+			Role:           token.RoleNode,
+		})
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("signed by another key", func(t *testing.T) {
+		t.Parallel()
+
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		err = sync(t, otherKey, token.Token{
+			StandardClaims: jwt.StandardClaims{Audience: nodeID},
+			Role:           token.RoleController,
+		})
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := client.Sync(context.Background(), connect.NewRequest(&agentv1.SyncRequest{}))
+		require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	syncStream := func(t *testing.T, tok token.Token) ([]*agentv1.SyncEvent, error) {
+		t.Helper()
+
+		tokenStr, err := token.GenerateJWTRSA(key, tok)
+		require.NoError(t, err)
+
+		streamClient := agentv1connect.NewAgentServiceClient(
+			srv.Client(),
+			srv.URL,
+			connect.WithInterceptors(NewClientAuthInterceptor(tokenStr)),
+		)
+		stream, err := streamClient.SyncStream(context.Background(), connect.NewRequest(&agentv1.SyncStreamRequest{}))
+		require.NoError(t, err)
+		defer stream.Close()
+
+		events := []*agentv1.SyncEvent{}
+		for stream.Receive() {
+			events = append(events, stream.Msg())
+		}
+		return events, stream.Err()
+	}
+
+	t.Run("stream with controller token", func(t *testing.T) {
+		t.Parallel()
+
+		events, err := syncStream(t, token.Token{
+			StandardClaims: jwt.StandardClaims{Audience: nodeID},
+			Role:           token.RoleController,
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+	})
+
+	t.Run("stream with node token", func(t *testing.T) {
+		t.Parallel()
+
+		events, err := syncStream(t, token.Token{
+			StandardClaims: jwt.StandardClaims{Audience: nodeID},
+			NodeID:         nodeID,
+			Role:           token.RoleNode,
+		})
+		require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		require.Empty(t, events)
+	})
+}
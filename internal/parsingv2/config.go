@@ -7,8 +7,11 @@ import (
 	"io/fs"
 	"path/filepath"
 	"regexp"
+	"strings"
+	"text/template"
 
 	"buf.build/go/protoyaml"
+	"github.com/Masterminds/semver/v3"
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/nicjohnson145/hlp"
 	configv1 "github.com/nicjohnson145/plantr/gen/plantr/config/v1"
@@ -18,6 +21,13 @@ var (
 	ErrParseError                     = errors.New("parse error")
 	ErrNodePublicKeyDecodeError       = errors.New("error decoding public key")
 	ErrGithubReleaseInvalidRegexError = errors.New("invalid regex")
+	ErrInvalidChecksumError           = errors.New("invalid checksum")
+	ErrInvalidSignatureError          = errors.New("invalid signature")
+	ErrInvalidTagConstraintError      = errors.New("invalid tag constraint")
+)
+
+var (
+	sha256Regex = regexp.MustCompile(`^[0-9a-f]{64}$`)
 )
 
 func ParseFS(fsys fs.FS) (*Config, error) {
@@ -81,6 +91,8 @@ func parseNode(node *configv1.Node) (*Node, error) {
 		OS:             node.Os,
 		Arch:           node.Arch,
 		PackageManager: node.PackageManager,
+		AgentAddress:   node.AgentAddress,
+		Channel:        node.Channel,
 	}, nil
 }
 
@@ -200,6 +212,12 @@ func parseSeed_githubRelease(release *configv1.GithubRelease) (*Seed, error) {
 		}
 	}
 
+	if IsTagConstraint(release.Tag) {
+		if _, err := semver.NewConstraint(release.Tag); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidTagConstraintError, err)
+		}
+	}
+
 	if release.BinaryRegex != nil {
 		_, err := regexp.Compile(*release.BinaryRegex)
 		if err != nil {
@@ -207,14 +225,30 @@ func parseSeed_githubRelease(release *configv1.GithubRelease) (*Seed, error) {
 		}
 	}
 
+	checksums, err := parseChecksums(release.Sha256)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := parseSignature(release.Signature)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Seed{
 		Element: &GithubRelease{
-			Repo:           release.Repo,
-			AssetPatterns:  assetPatterns,
-			Tag:            release.Tag,
-			NameOverride:   release.NameOverride,
-			ArchiveRelease: release.ArchiveRelease,
-			BinaryRegex:    release.BinaryRegex,
+			Repo:               release.Repo,
+			AssetPatterns:      assetPatterns,
+			Tag:                release.Tag,
+			NameOverride:       release.NameOverride,
+			ArchiveRelease:     release.ArchiveRelease,
+			BinaryRegex:        release.BinaryRegex,
+			Sha256:             checksums,
+			VerifyChecksums:    release.VerifyChecksums,
+			Signature:          sig,
+			IncludePrereleases: release.IncludePrereleases,
+			Host:               release.Host,
+			Provider:           release.Provider,
 		},
 	}, nil
 }
@@ -333,11 +367,88 @@ func parseSeed_urlDownload(urlDownload *configv1.UrlDownload) (*Seed, error) {
 		return nil, fmt.Errorf("must specify at least one OS/Arch url")
 	}
 
+	checksums, err := parseChecksums(urlDownload.Sha256)
+	if err != nil {
+		return nil, err
+	}
+	element.Sha256 = checksums
+
+	sig, err := parseSignature(urlDownload.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if sig != nil && sig.Asset != "" {
+		return nil, fmt.Errorf("%w: url_download signatures must be located by url", ErrInvalidSignatureError)
+	}
+	element.Signature = sig
+
 	return &Seed{
 		Element: element,
 	}, nil
 }
 
+// parseChecksums flattens the per OS/arch sha256 values, validating that each is a hex encoded sha256
+func parseChecksums(group *configv1.UrlDownload_OsGroup) (map[string]map[string]string, error) {
+	out := map[string]map[string]string{}
+	if group == nil {
+		return out, nil
+	}
+
+	setArch := func(os string, archGroup *configv1.UrlDownload_OsGroup_ArchGroup) error {
+		if archGroup == nil {
+			return nil
+		}
+
+		out[os] = map[string]string{}
+		for arch, value := range map[string]*string{"amd64": archGroup.Amd64, "arm64": archGroup.Arm64} {
+			if value == nil {
+				continue
+			}
+			sum := strings.ToLower(*value)
+			if !sha256Regex.MatchString(sum) {
+				return fmt.Errorf("%w: sha256 for %v/%v must be 64 hex characters", ErrInvalidChecksumError, os, arch)
+			}
+			out[os][arch] = sum
+		}
+		return nil
+	}
+
+	if err := setArch("linux", group.Linux); err != nil {
+		return nil, err
+	}
+	if err := setArch("darwin", group.Mac); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// parseSignature validates the signature location templates up front, so a typo fails at parse time rather than on
+// every node
+func parseSignature(sig *configv1.Signature) (*Signature, error) {
+	if sig == nil {
+		return nil, nil
+	}
+
+	out := &Signature{
+		Kind:      sig.Kind,
+		PublicKey: sig.PublicKey,
+		Asset:     sig.GetAsset(),
+		Url:       sig.GetUrl(),
+	}
+
+	for _, location := range []string{out.Asset, out.Url} {
+		if location == "" {
+			continue
+		}
+		if _, err := template.New("").Parse(location); err != nil {
+			return nil, fmt.Errorf("%w: error parsing signature location template: %w", ErrInvalidSignatureError, err)
+		}
+	}
+
+	return out, nil
+}
+
 func parseSeed_roleGroup(rootConfig *configv1.Config, fsys fs.FS, roleGroup *configv1.RoleGroup) ([]*Seed, error) {
 	if err := protovalidate.Validate(roleGroup); err != nil {
 		return nil, fmt.Errorf("error validating: %w", err)
@@ -260,6 +260,113 @@ func TestGithubRelease(t *testing.T) {
 			},
 			err: "invalid regex: error parsing binary regex",
 		},
+		{
+			name: "valid sha256",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Sha256 = &configv1.UrlDownload_OsGroup{
+					Linux: &configv1.UrlDownload_OsGroup_ArchGroup{
+						Amd64: hlp.Ptr("E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"),
+					},
+				}
+			},
+			err: "",
+		},
+		{
+			name: "invalid sha256",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Sha256 = &configv1.UrlDownload_OsGroup{
+					Mac: &configv1.UrlDownload_OsGroup_ArchGroup{
+						Arm64: hlp.Ptr("not-a-sha"),
+					},
+				}
+			},
+			err: "invalid checksum: sha256 for darwin/arm64 must be 64 hex characters",
+		},
+		{
+			name: "latest tag",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Tag = "latest"
+			},
+			err: "",
+		},
+		{
+			name: "tag constraint",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Tag = ">=2.0 <3"
+			},
+			err: "",
+		},
+		{
+			name: "invalid tag constraint",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Tag = "~not-a-version"
+			},
+			err: "invalid tag constraint",
+		},
+		{
+			name: "valid signature asset",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Signature = &configv1.Signature{
+					Kind:      "minisign",
+					PublicKey: "some-key",
+					Location:  &configv1.Signature_Asset{Asset: "{{ .Name }}.minisig"},
+				}
+			},
+			err: "",
+		},
+		{
+			name: "signature unknown kind",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Signature = &configv1.Signature{
+					Kind:      "cosign",
+					PublicKey: "some-key",
+					Location:  &configv1.Signature_Asset{Asset: "{{ .Name }}.sig"},
+				}
+			},
+			err: "kind must be one of minisign, openpgp",
+		},
+		{
+			name: "signature no location",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Signature = &configv1.Signature{
+					Kind:      "openpgp",
+					PublicKey: "some-key",
+				}
+			},
+			err: "exactly one field is required in oneof",
+		},
+		{
+			name: "signature invalid template",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Signature = &configv1.Signature{
+					Kind:      "openpgp",
+					PublicKey: "some-key",
+					Location:  &configv1.Signature_Url{Url: "{{ .Url }.asc"},
+				}
+			},
+			err: "invalid signature: error parsing signature location template",
+		},
+		{
+			name: "named host",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Host = "ghe"
+			},
+			err: "",
+		},
+		{
+			name: "gitlab provider",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Provider = "gitlab"
+			},
+			err: "",
+		},
+		{
+			name: "unknown provider",
+			modFunc: func(x *configv1.GithubRelease) {
+				x.Provider = "bitbucket"
+			},
+			err: "provider must be one of github, gitlab, gitea",
+		},
 	}
 	for _, tc := range testData {
 		t.Run(tc.name, func(t *testing.T) {
@@ -278,6 +385,36 @@ func TestGithubRelease(t *testing.T) {
 	}
 }
 
+func TestGithubRelease_Host(t *testing.T) {
+	t.Parallel()
+
+	public := &GithubRelease{Repo: "some/repo", Tag: "v1.0.0"}
+	enterprise := &GithubRelease{Repo: "some/repo", Tag: "v1.0.0", Host: "ghe"}
+
+	displayName, err := enterprise.DisplayName(nil)
+	require.NoError(t, err)
+	require.Equal(t, "ghe:some/repo@v1.0.0", displayName)
+
+	publicHash, err := public.ComputeHash(nil)
+	require.NoError(t, err)
+	enterpriseHash, err := enterprise.ComputeHash(nil)
+	require.NoError(t, err)
+	require.NotEqual(t, publicHash, enterpriseHash)
+
+	explicit := &GithubRelease{Repo: "some/repo", Tag: "v1.0.0", Provider: "github"}
+	explicitHash, err := explicit.ComputeHash(nil)
+	require.NoError(t, err)
+	require.Equal(t, publicHash, explicitHash)
+
+	gitlab := &GithubRelease{Repo: "some/repo", Tag: "v1.0.0", Provider: "gitlab"}
+	displayName, err = gitlab.DisplayName(nil)
+	require.NoError(t, err)
+	require.Equal(t, "gitlab:some/repo@v1.0.0", displayName)
+	gitlabHash, err := gitlab.ComputeHash(nil)
+	require.NoError(t, err)
+	require.NotEqual(t, publicHash, gitlabHash)
+}
+
 func TestSystemPackage(t *testing.T) {
 	t.Parallel()
 
@@ -568,6 +705,50 @@ func TestUrlDownload(t *testing.T) {
 			},
 			err: "must specify at least one OS/Arch url",
 		},
+		{
+			name: "valid sha256",
+			modFunc: func(x *configv1.UrlDownload) {
+				x.Sha256 = &configv1.UrlDownload_OsGroup{
+					Linux: &configv1.UrlDownload_OsGroup_ArchGroup{
+						Amd64: hlp.Ptr("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
+					},
+				}
+			},
+			err: "",
+		},
+		{
+			name: "invalid sha256",
+			modFunc: func(x *configv1.UrlDownload) {
+				x.Sha256 = &configv1.UrlDownload_OsGroup{
+					Linux: &configv1.UrlDownload_OsGroup_ArchGroup{
+						Amd64: hlp.Ptr("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b85"),
+					},
+				}
+			},
+			err: "invalid checksum: sha256 for linux/amd64 must be 64 hex characters",
+		},
+		{
+			name: "valid signature url",
+			modFunc: func(x *configv1.UrlDownload) {
+				x.Signature = &configv1.Signature{
+					Kind:      "openpgp",
+					PublicKey: "some-key",
+					Location:  &configv1.Signature_Url{Url: "{{ .Url }}.asc"},
+				}
+			},
+			err: "",
+		},
+		{
+			name: "signature asset",
+			modFunc: func(x *configv1.UrlDownload) {
+				x.Signature = &configv1.Signature{
+					Kind:      "openpgp",
+					PublicKey: "some-key",
+					Location:  &configv1.Signature_Asset{Asset: "{{ .Name }}.asc"},
+				}
+			},
+			err: "invalid signature: url_download signatures must be located by url",
+		},
 	}
 	for _, tc := range testData {
 		t.Run(tc.name, func(t *testing.T) {
@@ -676,3 +857,22 @@ func TestRoleGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTagConstraint(t *testing.T) {
+	t.Parallel()
+
+	for tag, want := range map[string]bool{
+		"v1.2.3":     false,
+		"1.2.3":      false,
+		"nightly":    false,
+		"latest":     false,
+		"~1.4":       true,
+		"^2":         true,
+		">=2.0 <3":   true,
+		"1.x":        true,
+		"1.2.*":      true,
+		"1.2 || 1.4": true,
+	} {
+		require.Equal(t, want, IsTagConstraint(tag), tag)
+	}
+}
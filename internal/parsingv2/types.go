@@ -27,6 +27,8 @@ type Node struct {
 	OS             string
 	Arch           string
 	PackageManager string
+	AgentAddress   string
+	Channel        string
 }
 
 type SeedMetadata struct {
@@ -79,25 +81,110 @@ func (c *ConfigFile) ComputeHash(_ *Node) (string, error) {
 
 var _ ISeed = (*GithubRelease)(nil)
 
+const TagLatest = "latest"
+
+const (
+	ReleaseProviderGithub = "github"
+	ReleaseProviderGitlab = "gitlab"
+	ReleaseProviderGitea  = "gitea"
+)
+
+// IsTagConstraint reports if tag should be treated as a semver constraint. Literal tags are far more common and can
+// themselves look like versions, so only tags using constraint syntax (operators, wildcards, ranges) count
+func IsTagConstraint(tag string) bool {
+	if tag == "" {
+		return false
+	}
+	if strings.ContainsAny(tag[:1], "~^<>=!") {
+		return true
+	}
+	return strings.ContainsAny(tag, "*|, ") || strings.HasSuffix(tag, ".x") || strings.Contains(tag, ".x.")
+}
+
 type GithubRelease struct {
-	Repo           string
-	AssetPatterns  map[string]map[string]*regexp.Regexp
-	Tag            string
-	NameOverride   *string
-	ArchiveRelease bool
-	BinaryRegex    *string
+	Repo            string
+	AssetPatterns   map[string]map[string]*regexp.Regexp
+	Tag             string
+	NameOverride    *string
+	ArchiveRelease  bool
+	BinaryRegex     *string
+	Sha256          map[string]map[string]string
+	VerifyChecksums bool
+	Signature       *Signature
+	// IncludePrereleases allows prereleases to satisfy a floating tag
+	IncludePrereleases bool
+	// ResolvedTag is the concrete tag a floating Tag currently resolves to, filled in by the controller
+	ResolvedTag string
+	// Host is the name of the release host the release lives on, empty for the default host
+	Host string
+	// Provider is the forge the release is published on, empty for github
+	Provider string
+}
+
+// Floating reports if the tag is "latest" or a semver constraint, rather than a literal tag
+func (g *GithubRelease) Floating() bool {
+	return g.Tag == TagLatest || IsTagConstraint(g.Tag)
+}
+
+// EffectiveTag returns the concrete tag if a floating tag has been resolved, otherwise the configured tag
+func (g *GithubRelease) EffectiveTag() string {
+	if g.ResolvedTag != "" {
+		return g.ResolvedTag
+	}
+	return g.Tag
 }
 
 func (g *GithubRelease) DisplayName(_ *Node) (string, error) {
-	return g.Repo + "@" + g.Tag, nil
+	return g.QualifiedRepo() + "@" + g.EffectiveTag(), nil
 }
 
-func (g *GithubRelease) ComputeHash(_ *Node) (string, error) {
-	return hash([]string{
+// OnGithub reports if the release is published on github (or GitHub Enterprise), rather than another forge
+func (g *GithubRelease) OnGithub() bool {
+	return g.Provider == "" || g.Provider == ReleaseProviderGithub
+}
+
+// QualifiedRepo is the repo, prefixed with the host name (or provider, if not github) for releases not on the default
+// host
+func (g *GithubRelease) QualifiedRepo() string {
+	if g.Host != "" {
+		return g.Host + ":" + g.Repo
+	}
+	if !g.OnGithub() {
+		return g.Provider + ":" + g.Repo
+	}
+	return g.Repo
+}
+
+func (g *GithubRelease) ComputeHash(node *Node) (string, error) {
+	parts := []string{
 		"GithubRelease",
 		g.Repo,
-		g.Tag,
-	}), nil
+		g.EffectiveTag(),
+	}
+	// Only when set, so adding checksum support didn't change the hash of every existing release
+	if sum := g.GetSha256(node); sum != nil {
+		parts = append(parts, *sum)
+	} else if g.VerifyChecksums {
+		parts = append(parts, "verify_checksums")
+	}
+	if g.Signature != nil {
+		parts = append(parts, g.Signature.hashParts()...)
+	}
+	if g.IncludePrereleases {
+		parts = append(parts, "include_prereleases")
+	}
+	if g.Host != "" {
+		parts = append(parts, "host", g.Host)
+	}
+	if !g.OnGithub() {
+		parts = append(parts, "provider", g.Provider)
+	}
+	return hash(parts), nil
+}
+
+// GetSha256 returns the expected sha256 of the asset for the node, if one was configured
+func (g *GithubRelease) GetSha256(node *Node) *string {
+	return getChecksum(g.Sha256, node)
 }
 
 func (g *GithubRelease) GetAssetPattern(os string, arch string) *regexp.Regexp {
@@ -267,6 +354,13 @@ type UrlDownload struct {
 	NameOverride   *string
 	Urls           map[string]map[string]string
 	ArchiveRelease bool
+	Sha256         map[string]map[string]string
+	Signature      *Signature
+}
+
+// GetSha256 returns the expected sha256 of the download for the node, if one was configured
+func (u *UrlDownload) GetSha256(node *Node) *string {
+	return getChecksum(u.Sha256, node)
 }
 
 func (u *UrlDownload) GetUrl(node *Node) (string, error) {
@@ -299,8 +393,39 @@ func (u *UrlDownload) ComputeHash(node *Node) (string, error) {
 		return "", err
 	}
 
-	return hash([]string{
+	parts := []string{
 		"UrlDownload",
 		url,
-	}), nil
+	}
+	if sum := u.GetSha256(node); sum != nil {
+		parts = append(parts, *sum)
+	}
+	if u.Signature != nil {
+		parts = append(parts, u.Signature.hashParts()...)
+	}
+	return hash(parts), nil
+}
+
+// Signature is a detached signature to verify a download against. Exactly one of Asset and Url is set, both are
+// templates rendered by the controller
+type Signature struct {
+	Kind      string
+	PublicKey string
+	Asset     string
+	Url       string
+}
+
+func (s *Signature) hashParts() []string {
+	return []string{"Signature", s.Kind, s.PublicKey, s.Asset, s.Url}
+}
+
+func getChecksum(checksums map[string]map[string]string, node *Node) *string {
+	if node == nil {
+		return nil
+	}
+	sum, ok := checksums[node.OS][node.Arch]
+	if !ok {
+		return nil
+	}
+	return &sum
 }
@@ -0,0 +1,158 @@
+package signature
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	minisignKeyIDSize = 8
+	// signatures over the raw content
+	minisignAlgLegacy = "Ed"
+	// signatures over the blake2b-512 of the content, the default since minisign 0.8
+	minisignAlgHashed = "ED"
+)
+
+type minisignPublicKey struct {
+	keyID []byte
+	key   ed25519.PublicKey
+}
+
+type minisignSignature struct {
+	algorithm      string
+	keyID          []byte
+	signature      []byte
+	trustedComment string
+	globalSig      []byte
+}
+
+// verifyMinisign checks both the signature over the content and the global signature over the trusted comment, so a
+// tampered comment is rejected just like tampered content
+func verifyMinisign(publicKey string, content io.Reader, sig []byte) error {
+	key, err := parseMinisignPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := parseMinisignSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(key.keyID, parsed.keyID) {
+		return fmt.Errorf(
+			"%w: signed by key %X, expected %X",
+			ErrVerificationFailedError,
+			reverse(parsed.keyID),
+			reverse(key.keyID),
+		)
+	}
+
+	var message []byte
+	switch parsed.algorithm {
+	case minisignAlgLegacy:
+		message, err = io.ReadAll(content)
+		if err != nil {
+			return fmt.Errorf("error reading content: %w", err)
+		}
+	case minisignAlgHashed:
+		hasher, err := blake2b.New512(nil)
+		if err != nil {
+			return fmt.Errorf("error creating hasher: %w", err)
+		}
+		if _, err := io.Copy(hasher, content); err != nil {
+			return fmt.Errorf("error hashing content: %w", err)
+		}
+		message = hasher.Sum(nil)
+	default:
+		return fmt.Errorf("unsupported minisign signature algorithm %q", parsed.algorithm)
+	}
+
+	if !ed25519.Verify(key.key, message, parsed.signature) {
+		return fmt.Errorf("%w: invalid signature", ErrVerificationFailedError)
+	}
+
+	global := append(append([]byte{}, parsed.signature...), []byte(parsed.trustedComment)...)
+	if !ed25519.Verify(key.key, global, parsed.globalSig) {
+		return fmt.Errorf("%w: invalid trusted comment signature", ErrVerificationFailedError)
+	}
+
+	return nil
+}
+
+// parseMinisignPublicKey accepts either the full .pub file, or just the base64 encoded key line
+func parseMinisignPublicKey(publicKey string) (*minisignPublicKey, error) {
+	encoded := ""
+	for _, line := range strings.Split(publicKey, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "untrusted comment:") {
+			continue
+		}
+		encoded = line
+		break
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding minisign public key: %w", err)
+	}
+	if len(raw) != 2+minisignKeyIDSize+ed25519.PublicKeySize || string(raw[:2]) != minisignAlgLegacy {
+		return nil, fmt.Errorf("invalid minisign public key")
+	}
+
+	return &minisignPublicKey{
+		keyID: raw[2 : 2+minisignKeyIDSize],
+		key:   ed25519.PublicKey(raw[2+minisignKeyIDSize:]),
+	}, nil
+}
+
+func parseMinisignSignature(sig []byte) (*minisignSignature, error) {
+	lines := strings.Split(strings.TrimSpace(strings.ReplaceAll(string(sig), "\r\n", "\n")), "\n")
+	if len(lines) != 4 {
+		return nil, fmt.Errorf("invalid minisign signature, expected 4 lines, got %v", len(lines))
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding minisign signature: %w", err)
+	}
+	if len(raw) != 2+minisignKeyIDSize+ed25519.SignatureSize {
+		return nil, fmt.Errorf("invalid minisign signature length")
+	}
+
+	trustedComment, ok := strings.CutPrefix(lines[2], "trusted comment: ")
+	if !ok {
+		return nil, fmt.Errorf("invalid minisign signature, missing trusted comment")
+	}
+
+	globalSig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding minisign global signature: %w", err)
+	}
+	if len(globalSig) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("invalid minisign global signature length")
+	}
+
+	return &minisignSignature{
+		algorithm:      string(raw[:2]),
+		keyID:          raw[2 : 2+minisignKeyIDSize],
+		signature:      raw[2+minisignKeyIDSize:],
+		trustedComment: trustedComment,
+		globalSig:      globalSig,
+	}, nil
+}
+
+// reverse returns a reversed copy of b, minisign stores key ids little endian but displays them big endian
+func reverse(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package signature
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+)
+
+const armoredSignaturePrefix = "-----BEGIN PGP SIGNATURE"
+
+// verifyOpenPGP checks a detached signature, either armored (.asc) or binary (.sig), against a keyring which may hold
+// several keys, any of which is accepted
+func verifyOpenPGP(publicKey string, content io.Reader, sig []byte) error {
+	keyring, err := readKeyRing(publicKey)
+	if err != nil {
+		return err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(sig), []byte(armoredSignaturePrefix)) {
+		_, err = openpgp.CheckArmoredDetachedSignature(keyring, content, bytes.NewReader(sig), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(keyring, content, bytes.NewReader(sig), nil)
+	}
+	if err != nil {
+		var signatureErr pgperrors.SignatureError
+		if errors.Is(err, pgperrors.ErrUnknownIssuer) || errors.As(err, &signatureErr) {
+			return fmt.Errorf("%w: %w", ErrVerificationFailedError, err)
+		}
+		return fmt.Errorf("error checking signature: %w", err)
+	}
+
+	return nil
+}
+
+func readKeyRing(publicKey string) (openpgp.EntityList, error) {
+	if strings.Contains(publicKey, "-----BEGIN PGP") {
+		keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("error reading armored public key: %w", err)
+		}
+		return keyring, nil
+	}
+
+	keyring, err := openpgp.ReadKeyRing(strings.NewReader(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("error reading public key: %w", err)
+	}
+	return keyring, nil
+}
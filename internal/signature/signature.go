@@ -0,0 +1,30 @@
+package signature
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	KindMinisign = "minisign"
+	KindOpenPGP  = "openpgp"
+)
+
+var (
+	ErrVerificationFailedError = errors.New("signature verification failed")
+	ErrUnknownKindError        = errors.New("unknown signature kind")
+)
+
+// Verify checks the detached signature sig over content against publicKey. publicKey is either the contents of a
+// minisign .pub file, or an OpenPGP public key, armored or not
+func Verify(kind string, publicKey string, content io.Reader, sig []byte) error {
+	switch kind {
+	case KindMinisign:
+		return verifyMinisign(publicKey, content, sig)
+	case KindOpenPGP:
+		return verifyOpenPGP(publicKey, content, sig)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownKindError, kind)
+	}
+}
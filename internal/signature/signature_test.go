@@ -0,0 +1,184 @@
+package signature
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	content         = "some-binary-content"
+	tamperedContent = "some-other-binary-content"
+)
+
+type minisignKey struct {
+	keyID   []byte
+	private ed25519.PrivateKey
+	public  string
+}
+
+func newMinisignKey(t *testing.T) *minisignKey {
+	t.Helper()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keyID := make([]byte, minisignKeyIDSize)
+	_, err = rand.Read(keyID)
+	require.NoError(t, err)
+
+	raw := append(append([]byte(minisignAlgLegacy), keyID...), public...)
+	return &minisignKey{
+		keyID:   keyID,
+		private: private,
+		public:  "untrusted comment: minisign public key\n" + base64.StdEncoding.EncodeToString(raw) + "\n",
+	}
+}
+
+func (k *minisignKey) sign(t *testing.T, algorithm string, message string) []byte {
+	t.Helper()
+
+	signed := []byte(message)
+	if algorithm == minisignAlgHashed {
+		sum := blake2b.Sum512(signed)
+		signed = sum[:]
+	}
+
+	sig := ed25519.Sign(k.private, signed)
+	trustedComment := "timestamp:1700000000\tfile:some-binary"
+	globalSig := ed25519.Sign(k.private, append(append([]byte{}, sig...), []byte(trustedComment)...))
+
+	raw := append(append([]byte(algorithm), k.keyID...), sig...)
+	return []byte(fmt.Sprintf(
+		"untrusted comment: signature from minisign secret key\n%v\ntrusted comment: %v\n%v\n",
+		base64.StdEncoding.EncodeToString(raw),
+		trustedComment,
+		base64.StdEncoding.EncodeToString(globalSig),
+	))
+}
+
+func TestVerifyMinisign(t *testing.T) {
+	t.Parallel()
+
+	key := newMinisignKey(t)
+	otherKey := newMinisignKey(t)
+
+	t.Run("hashed", func(t *testing.T) {
+		t.Parallel()
+		sig := key.sign(t, minisignAlgHashed, content)
+		require.NoError(t, Verify(KindMinisign, key.public, strings.NewReader(content), sig))
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		t.Parallel()
+		sig := key.sign(t, minisignAlgLegacy, content)
+		require.NoError(t, Verify(KindMinisign, key.public, strings.NewReader(content), sig))
+	})
+
+	t.Run("bare public key", func(t *testing.T) {
+		t.Parallel()
+		sig := key.sign(t, minisignAlgHashed, content)
+		bare := strings.Split(key.public, "\n")[1]
+		require.NoError(t, Verify(KindMinisign, bare, strings.NewReader(content), sig))
+	})
+
+	t.Run("tampered content", func(t *testing.T) {
+		t.Parallel()
+		sig := key.sign(t, minisignAlgHashed, content)
+		err := Verify(KindMinisign, key.public, strings.NewReader(tamperedContent), sig)
+		require.ErrorIs(t, err, ErrVerificationFailedError)
+	})
+
+	t.Run("tampered trusted comment", func(t *testing.T) {
+		t.Parallel()
+		sig := bytes.Replace(key.sign(t, minisignAlgHashed, content), []byte("file:some-binary"), []byte("file:other-binary"), 1)
+		err := Verify(KindMinisign, key.public, strings.NewReader(content), sig)
+		require.ErrorIs(t, err, ErrVerificationFailedError)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		t.Parallel()
+		sig := otherKey.sign(t, minisignAlgHashed, content)
+		err := Verify(KindMinisign, key.public, strings.NewReader(content), sig)
+		require.ErrorIs(t, err, ErrVerificationFailedError)
+	})
+
+	t.Run("malformed signature", func(t *testing.T) {
+		t.Parallel()
+		err := Verify(KindMinisign, key.public, strings.NewReader(content), []byte("not a signature"))
+		require.Error(t, err)
+	})
+}
+
+func TestVerifyOpenPGP(t *testing.T) {
+	t.Parallel()
+
+	newKey := func(t *testing.T) (*openpgp.Entity, string) {
+		t.Helper()
+
+		entity, err := openpgp.NewEntity("Some User", "", "some-user@example.com", nil)
+		require.NoError(t, err)
+
+		buf := &bytes.Buffer{}
+		w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+		require.NoError(t, err)
+		require.NoError(t, entity.Serialize(w))
+		require.NoError(t, w.Close())
+
+		return entity, buf.String()
+	}
+
+	entity, public := newKey(t)
+	otherEntity, _ := newKey(t)
+
+	armoredSig := func(t *testing.T, signer *openpgp.Entity) []byte {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		require.NoError(t, openpgp.ArmoredDetachSign(buf, signer, strings.NewReader(content), nil))
+		return buf.Bytes()
+	}
+
+	binarySig := func(t *testing.T, signer *openpgp.Entity) []byte {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		require.NoError(t, openpgp.DetachSign(buf, signer, strings.NewReader(content), nil))
+		return buf.Bytes()
+	}
+
+	t.Run("armored", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, Verify(KindOpenPGP, public, strings.NewReader(content), armoredSig(t, entity)))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, Verify(KindOpenPGP, public, strings.NewReader(content), binarySig(t, entity)))
+	})
+
+	t.Run("tampered content", func(t *testing.T) {
+		t.Parallel()
+		err := Verify(KindOpenPGP, public, strings.NewReader(tamperedContent), armoredSig(t, entity))
+		require.ErrorIs(t, err, ErrVerificationFailedError)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		t.Parallel()
+		err := Verify(KindOpenPGP, public, strings.NewReader(content), armoredSig(t, otherEntity))
+		require.ErrorIs(t, err, ErrVerificationFailedError)
+	})
+}
+
+func TestVerifyUnknownKind(t *testing.T) {
+	t.Parallel()
+	err := Verify("cosign", "some-key", strings.NewReader(content), []byte("some-sig"))
+	require.ErrorIs(t, err, ErrUnknownKindError)
+}
@@ -1,15 +1,33 @@
 package token
 
 import (
+	"crypto/rsa"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt"
 )
 
+type Role string
+
+const (
+	RoleNode       Role = "node"
+	RoleAdmin      Role = "admin"
+	RoleController Role = "controller"
+)
+
 type Token struct {
 	jwt.StandardClaims
 	NodeID string `json:"node_id"`
+	Role   Role   `json:"role,omitempty"`
+}
+
+// GetRole returns the role of the token, tokens issued before roles existed are treated as node tokens
+func (t *Token) GetRole() Role {
+	if t.Role == "" {
+		return RoleNode
+	}
+	return t.Role
 }
 
 func GenerateJWT(signingKey []byte, claims Token) (string, error) {
@@ -35,6 +53,29 @@ func ParseJWT(tokenStr string, signingKey []byte) (*Token, error) {
 	return token.Claims.(*Token), nil
 }
 
+func GenerateJWTRSA(privateKey *rsa.PrivateKey, claims Token) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	str, err := token.SignedString(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("error signing JWT: %w", err)
+	}
+	return str, nil
+}
+
+func ParseJWTRSA(tokenStr string, publicKey *rsa.PublicKey) (*Token, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &Token{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("invalid signing method")
+		}
+		return publicKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing JWT: %w", err)
+	}
+
+	return token.Claims.(*Token), nil
+}
+
 func ExtractExpiration(tokenStr string) (time.Time, error) {
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenStr, Token{})
 	if err != nil {
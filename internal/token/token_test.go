@@ -1,6 +1,8 @@
 package token
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,3 +24,39 @@ func TestTokenLoop(t *testing.T) {
 
 	require.Equal(t, &token, outToken)
 }
+
+func TestTokenLoopRSA(t *testing.T) {
+	t.Parallel()
+
+	token := Token{
+		NodeID: "some-id",
+		Role:   RoleController,
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tokenStr, err := GenerateJWTRSA(key, token)
+	require.NoError(t, err)
+
+	outToken, err := ParseJWTRSA(tokenStr, &key.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, &token, outToken)
+
+	t.Run("hmac token rejected", func(t *testing.T) {
+		t.Parallel()
+
+		hmacStr, err := GenerateJWT([]byte(`some-big-uuid`), token)
+		require.NoError(t, err)
+
+		_, err = ParseJWTRSA(hmacStr, &key.PublicKey)
+		require.Error(t, err)
+	})
+}
+
+func TestGetRole(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, RoleNode, (&Token{}).GetRole())
+	require.Equal(t, RoleNode, (&Token{Role: RoleNode}).GetRole())
+	require.Equal(t, RoleAdmin, (&Token{Role: RoleAdmin}).GetRole())
+}